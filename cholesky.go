@@ -0,0 +1,182 @@
+package wyvern
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Cholesky is the Cholesky factorization of a symmetric positive definite Matrix
+// A: A = LL', where L is lower triangular with a positive diagonal.
+type Cholesky[N constraints.Float] struct {
+	l Matrix[N]
+}
+
+// IsSymmetric returns true if the Matrix is square and each entry differs from
+// its mirror image across the diagonal by no more than tol.
+func (a Matrix[N]) IsSymmetric(tol N) bool {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return false
+	}
+
+	for ci, c := range a.columnViews() {
+		for ri := ci + 1; ri < rows; ri++ {
+			if abs(c[ri]-a.col(ri)[ci]) > tol {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Cholesky computes the Cholesky factorization of the Matrix.  ErrNotSquare is
+// returned if the Matrix is not square, ErrNotSymmetric if it is not symmetric
+// (to within rounding error), and ErrNotPositiveDefinite if it is not positive
+// definite.
+func (a Matrix[N]) Cholesky() (*Cholesky[N], error) {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return nil, ErrNotSquare
+	}
+
+	if !a.IsSymmetric(a.pivotTolerance()) {
+		return nil, ErrNotSymmetric
+	}
+
+	l := make([]Vector[N], cols)
+	for j := range l {
+		// Column j of L is column j of A, less the contributions of
+		// the columns already computed, scaled by the new diagonal.
+		c := make(Vector[N], rows)
+		copy(c[j:], a.col(j)[j:])
+		for k := 0; k < j; k++ {
+			lk := l[k]
+			for ri := j; ri < rows; ri++ {
+				c[ri] -= lk[ri] * lk[j]
+			}
+		}
+
+		if c[j] <= 0 {
+			return nil, ErrNotPositiveDefinite
+		}
+
+		c.Multiply(1 / N(math.Sqrt(float64(c[j]))))
+		l[j] = c
+	}
+
+	return &Cholesky[N]{l: fromColumns(l)}, nil
+}
+
+// CholeskySolve solves Ax = b, where A is the Matrix, which must be symmetric
+// positive definite.  For such systems this is roughly twice as fast as Solve.
+func (a Matrix[N]) CholeskySolve(b Vector[N]) (Vector[N], error) {
+	f, err := a.Cholesky()
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Solve(b)
+}
+
+// L returns the lower triangular factor.
+func (f *Cholesky[N]) L() Matrix[N] {
+	return f.l.Clone()
+}
+
+// Solve solves Ax = b for x, where A is the factored Matrix.  A *DimensionError
+// is returned if b has the wrong dimension.
+func (f *Cholesky[N]) Solve(b Vector[N]) (Vector[N], error) {
+	n := f.l.cols
+	if len(b) != n {
+		return nil, &DimensionError{
+			Op:       "Cholesky.Solve",
+			Expected: Shape{Rows: n, Columns: 1},
+			Actual:   Shape{Rows: len(b), Columns: 1},
+		}
+	}
+
+	x := append(Vector[N]{}, b...)
+
+	// Forward substitution: Ly = b.
+	for k, c := range f.l.columnViews() {
+		x[k] /= c[k]
+		for ri := k + 1; ri < n; ri++ {
+			x[ri] -= c[ri] * x[k]
+		}
+	}
+
+	// Back substitution: L'x = y.  Row k of L' is column k of L.
+	for k := n - 1; k >= 0; k-- {
+		c := f.l.col(k)
+		for ri := k + 1; ri < n; ri++ {
+			x[k] -= c[ri] * x[ri]
+		}
+		x[k] /= c[k]
+	}
+
+	return x, nil
+}
+
+// Determinant returns the determinant of the factored Matrix.
+func (f *Cholesky[N]) Determinant() N {
+	var det N = 1
+	for k, c := range f.l.columnViews() {
+		det *= c[k] * c[k]
+	}
+
+	return det
+}
+
+// Update modifies the factorization, in place, to be that of A + xx', where A is
+// the Matrix previously factored.  This takes O(n^2) time rather than the O(n^3)
+// needed to factor A + xx' from scratch.  A *DimensionError is returned if x has
+// the wrong dimension.
+func (f *Cholesky[N]) Update(x Vector[N]) error {
+	return f.rankOne(x, 1, "Cholesky.Update")
+}
+
+// Downdate modifies the factorization, in place, to be that of A - xx', where A
+// is the Matrix previously factored.  ErrNotPositiveDefinite is returned, and
+// the factorization left unchanged, if A - xx' is not positive definite.  A
+// *DimensionError is returned if x has the wrong dimension.
+func (f *Cholesky[N]) Downdate(x Vector[N]) error {
+	return f.rankOne(x, -1, "Cholesky.Downdate")
+}
+
+// rankOne factors A + sign*xx' by applying a sequence of rotations to L.  The
+// work is done on a copy, so that a failed downdate does not corrupt f.
+func (f *Cholesky[N]) rankOne(x Vector[N], sign N, op string) error {
+	n := f.l.cols
+	if len(x) != n {
+		return &DimensionError{
+			Op:       op,
+			Expected: Shape{Rows: n, Columns: 1},
+			Actual:   Shape{Rows: len(x), Columns: 1},
+		}
+	}
+
+	l := f.l.Columns()
+	w := append(Vector[N]{}, x...)
+
+	for k, c := range l {
+		rr := c[k]*c[k] + sign*w[k]*w[k]
+		if rr <= 0 {
+			return ErrNotPositiveDefinite
+		}
+
+		r := N(math.Sqrt(float64(rr)))
+		cos := r / c[k]
+		sin := w[k] / c[k]
+		c[k] = r
+
+		for ri := k + 1; ri < n; ri++ {
+			c[ri] = (c[ri] + sign*sin*w[ri]) / cos
+			w[ri] = cos*w[ri] - sin*c[ri]
+		}
+	}
+
+	f.l = fromColumns(l)
+	return nil
+}
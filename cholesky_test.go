@@ -0,0 +1,180 @@
+package wyvern_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+var _ = Describe("Cholesky", func() {
+	var (
+		mt   wyvern.Matrix[float64]
+		rows []wyvern.Vector[float64]
+	)
+
+	BeforeEach(func() {
+		rows = []wyvern.Vector[float64]{
+			{4, 12, -16},
+			{12, 37, -43},
+			{-16, -43, 98},
+		}
+	})
+
+	JustBeforeEach(func() {
+		mt, _ = wyvern.FromRows(rows)
+	})
+
+	Describe("IsSymmetric", func() {
+		It("Returns true for a symmetric matrix", func() {
+			Expect(mt.IsSymmetric(0)).To(BeTrue())
+		})
+
+		When("The matrix is not symmetric", func() {
+			BeforeEach(func() {
+				rows[0][1] = 12.5
+			})
+
+			It("Returns false", func() {
+				Expect(mt.IsSymmetric(0.1)).To(BeFalse())
+			})
+
+			It("Returns true if the difference is within the tolerance", func() {
+				Expect(mt.IsSymmetric(0.5)).To(BeTrue())
+			})
+		})
+
+		When("The matrix is not square", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2, 3},
+					{2, 1, 4},
+				}
+			})
+
+			It("Returns false", func() {
+				Expect(mt.IsSymmetric(1)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Cholesky", func() {
+		It("Returns the lower triangular factor", func() {
+			f, e := mt.Cholesky()
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorsNear(f.L().Rows(), []wyvern.Vector[float64]{
+				{2, 0, 0},
+				{6, 1, 0},
+				{-8, 5, 3},
+			}, 1e-12)
+		})
+
+		It("Returns the determinant", func() {
+			f, _ := mt.Cholesky()
+			Expect(f.Determinant()).To(BeNumerically("~", 36, 1e-10))
+		})
+
+		When("The matrix is not positive definite", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2},
+					{2, 1},
+				}
+			})
+
+			It("Returns ErrNotPositiveDefinite", func() {
+				f, e := mt.Cholesky()
+				Expect(f).To(BeNil())
+				Expect(e).To(MatchError(wyvern.ErrNotPositiveDefinite))
+			})
+		})
+
+		When("The matrix is not symmetric", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{4, 1},
+					{3, 4},
+				}
+			})
+
+			It("Returns ErrNotSymmetric", func() {
+				_, e := mt.Cholesky()
+				Expect(e).To(MatchError(wyvern.ErrNotSymmetric))
+			})
+		})
+
+		When("The matrix is not square", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{4, 1, 0},
+					{1, 4, 1},
+				}
+			})
+
+			It("Returns ErrNotSquare", func() {
+				_, e := mt.Cholesky()
+				Expect(e).To(MatchError(wyvern.ErrNotSquare))
+			})
+		})
+	})
+
+	Describe("CholeskySolve", func() {
+		It("Solves the system", func() {
+			x, e := mt.CholeskySolve(wyvern.Vector[float64]{0, 6, 39})
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(x, wyvern.Vector[float64]{1, 1, 1}, 1e-12)
+		})
+
+		When("The right-hand side has the wrong dimension", func() {
+			It("Returns a DimensionError", func() {
+				_, e := mt.CholeskySolve(wyvern.Vector[float64]{0, 6})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Update and Downdate", func() {
+		var (
+			f *wyvern.Cholesky[float64]
+			x wyvern.Vector[float64]
+		)
+
+		JustBeforeEach(func() {
+			f, _ = mt.Cholesky()
+			x = wyvern.Vector[float64]{1, 0, 2}
+		})
+
+		It("Update yields the factorization of A + xx'", func() {
+			Expect(f.Update(x)).To(Succeed())
+
+			updated, _ := wyvern.FromRows([]wyvern.Vector[float64]{
+				{5, 12, -14},
+				{12, 37, -43},
+				{-14, -43, 102},
+			})
+			expected, _ := updated.Cholesky()
+			expectVectorsNear(f.L().Rows(), expected.L().Rows(), 1e-12)
+		})
+
+		It("Downdate reverses Update", func() {
+			original := f.L().Rows()
+			Expect(f.Update(x)).To(Succeed())
+			Expect(f.Downdate(x)).To(Succeed())
+			expectVectorsNear(f.L().Rows(), original, 1e-12)
+		})
+
+		When("The downdated matrix is not positive definite", func() {
+			It("Returns ErrNotPositiveDefinite and leaves the factorization unchanged", func() {
+				original := f.L().Rows()
+				Expect(f.Downdate(wyvern.Vector[float64]{3, 0, 0})).To(MatchError(wyvern.ErrNotPositiveDefinite))
+				Expect(f.L().Rows()).To(Equal(original))
+			})
+		})
+
+		When("The vector has the wrong dimension", func() {
+			It("Returns a DimensionError", func() {
+				Expect(f.Update(wyvern.Vector[float64]{1, 2})).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+})
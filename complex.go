@@ -0,0 +1,306 @@
+package wyvern
+
+import (
+	"math"
+	"math/cmplx"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A CVector is a vector with complex components.  It is the complex counterpart
+// of Vector; Vector itself remains restricted to real components so that
+// operations such as Angle and the orderings used by pivoting stay meaningful.
+type CVector[C constraints.Complex] []C
+
+// conj returns the complex conjugate of z.
+func conj[C constraints.Complex](z C) C {
+	return C(cmplx.Conj(complex128(z)))
+}
+
+// DotProduct returns the Hermitian inner product of v and w: the sum of
+// conj(v[i]) * w[i].  Conjugating v makes v.DotProduct(v) real and equal to the
+// square of v's magnitude.  A *DimensionError is returned if the vectors have
+// different dimensions.
+func (v CVector[C]) DotProduct(w CVector[C]) (C, error) {
+	if len(v) != len(w) {
+		return 0, &DimensionError{
+			Op:       "DotProduct",
+			Expected: Shape{Rows: len(v), Columns: 1},
+			Actual:   Shape{Rows: len(w), Columns: 1},
+		}
+	}
+
+	var sum C
+	for i, val := range v {
+		sum += conj(val) * w[i]
+	}
+
+	return sum, nil
+}
+
+// Magnitude returns the Euclidean length of the vector.
+func (v CVector[C]) Magnitude() float64 {
+	var sumOfSquares float64
+	for _, val := range v {
+		z := complex128(val)
+		sumOfSquares += real(z)*real(z) + imag(z)*imag(z)
+	}
+
+	return math.Sqrt(sumOfSquares)
+}
+
+// Conjugate returns a new CVector whose components are the complex conjugates of
+// those of v.
+func (v CVector[C]) Conjugate() CVector[C] {
+	c := make(CVector[C], len(v))
+	for i, val := range v {
+		c[i] = conj(val)
+	}
+
+	return c
+}
+
+// A CMatrix is a matrix with complex entries, the complex counterpart of Matrix.
+// Like Matrix, it stores its entries in a single slice in column-major order.
+type CMatrix[C constraints.Complex] struct {
+	data       []C
+	rows, cols int
+}
+
+// newCMatrix returns a rows x cols CMatrix with every entry zero.
+func newCMatrix[C constraints.Complex](rows, cols int) CMatrix[C] {
+	return CMatrix[C]{data: make([]C, rows*cols), rows: rows, cols: cols}
+}
+
+// CFromRows returns a CMatrix with the given rows, which are copied.  A
+// *DimensionError is returned if the rows do not all have the same dimension.
+func CFromRows[C constraints.Complex](rows []CVector[C]) (CMatrix[C], error) {
+	if err := checkCDimensionCount("CFromRows", rows); err != nil {
+		return CMatrix[C]{}, err
+	}
+
+	m := newCMatrix[C](len(rows), 0)
+	if len(rows) > 0 {
+		m = newCMatrix[C](len(rows), len(rows[0]))
+	}
+
+	for ri, row := range rows {
+		for ci, val := range row {
+			m.data[ci*m.rows+ri] = val
+		}
+	}
+
+	return m, nil
+}
+
+// CFromColumns returns a CMatrix with the given columns, which are copied.  A
+// *DimensionError is returned if the columns do not all have the same dimension.
+func CFromColumns[C constraints.Complex](c []CVector[C]) (CMatrix[C], error) {
+	if err := checkCDimensionCount("CFromColumns", c); err != nil {
+		return CMatrix[C]{}, err
+	}
+
+	m := newCMatrix[C](0, len(c))
+	if len(c) > 0 {
+		m = newCMatrix[C](len(c[0]), len(c))
+	}
+
+	for ci, col := range c {
+		copy(m.col(ci), col)
+	}
+
+	return m, nil
+}
+
+// checkCDimensionCount returns a *DimensionError if the vectors do not all have
+// the same number of components.
+func checkCDimensionCount[C constraints.Complex](op string, c []CVector[C]) error {
+	for i := 1; i < len(c); i++ {
+		if len(c[i]) != len(c[0]) {
+			return &DimensionError{
+				Op:       op,
+				Expected: Shape{Rows: len(c[0]), Columns: 1},
+				Actual:   Shape{Rows: len(c[i]), Columns: 1},
+			}
+		}
+	}
+
+	return nil
+}
+
+// Complex returns the Matrix as a CMatrix with zero imaginary parts.  The result
+// is always a CMatrix[complex128], so a Matrix[float32] is widened; use
+// CFromMatrix for a CMatrix[complex64].
+func (a Matrix[N]) Complex() CMatrix[complex128] {
+	return CFromMatrix[complex128](a)
+}
+
+// CFromMatrix returns the real Matrix a as a CMatrix of the given complex type,
+// with zero imaginary parts.  For example, CFromMatrix[complex64](a) keeps a
+// Matrix[float32] in single precision.
+func CFromMatrix[C constraints.Complex, N constraints.Float](a Matrix[N]) CMatrix[C] {
+	m := newCMatrix[C](a.rows, a.cols)
+	for ci, c := range a.columnViews() {
+		mc := m.col(ci)
+		for ri, val := range c {
+			mc[ri] = C(complex(float64(val), 0))
+		}
+	}
+
+	return m
+}
+
+// col returns column j as a CVector sharing the CMatrix's storage.
+func (a CMatrix[C]) col(j int) CVector[C] {
+	start := j * a.rows
+	return CVector[C](a.data[start : start+a.rows : start+a.rows])
+}
+
+// Dims returns the number of rows and columns in the CMatrix.
+func (a CMatrix[C]) Dims() (rows, cols int) {
+	return a.rows, a.cols
+}
+
+// At returns the entry at the specified row and column.  An *IndexError is
+// returned if either index is out of range.
+func (a CMatrix[C]) At(rowIndex, columnIndex int) (C, error) {
+	if rowIndex < 0 || rowIndex >= a.rows {
+		return 0, &IndexError{Op: "At", Index: rowIndex, Limit: a.rows}
+	}
+
+	if columnIndex < 0 || columnIndex >= a.cols {
+		return 0, &IndexError{Op: "At", Index: columnIndex, Limit: a.cols}
+	}
+
+	return a.data[columnIndex*a.rows+rowIndex], nil
+}
+
+// Row returns a copy of the specified row.  An *IndexError is returned if the
+// index is out of range.
+func (a CMatrix[C]) Row(rowIndex int) (CVector[C], error) {
+	if rowIndex < 0 || rowIndex >= a.rows {
+		return nil, &IndexError{Op: "Row", Index: rowIndex, Limit: a.rows}
+	}
+
+	row := make(CVector[C], a.cols)
+	for ci := range row {
+		row[ci] = a.data[ci*a.rows+rowIndex]
+	}
+
+	return row, nil
+}
+
+// Column returns a copy of the specified column.  An *IndexError is returned if
+// the index is out of range.
+func (a CMatrix[C]) Column(columnIndex int) (CVector[C], error) {
+	if columnIndex < 0 || columnIndex >= a.cols {
+		return nil, &IndexError{Op: "Column", Index: columnIndex, Limit: a.cols}
+	}
+
+	return append(CVector[C]{}, a.col(columnIndex)...), nil
+}
+
+// Rows returns copies of the rows of the CMatrix, top to bottom.
+func (a CMatrix[C]) Rows() []CVector[C] {
+	return a.Transpose().Columns()
+}
+
+// Columns returns copies of the columns of the CMatrix, left to right.
+func (a CMatrix[C]) Columns() []CVector[C] {
+	cols := make([]CVector[C], a.cols)
+	for ci := range cols {
+		cols[ci] = append(CVector[C]{}, a.col(ci)...)
+	}
+
+	return cols
+}
+
+// Transpose returns the transpose of the CMatrix as a new CMatrix.  The entries
+// are not conjugated; see ConjugateTranspose.
+func (a CMatrix[C]) Transpose() CMatrix[C] {
+	t := newCMatrix[C](a.cols, a.rows)
+	for ci := 0; ci < a.cols; ci++ {
+		for ri, val := range a.col(ci) {
+			t.data[ri*t.rows+ci] = val
+		}
+	}
+
+	return t
+}
+
+// ConjugateTranspose returns the conjugate (Hermitian) transpose of the CMatrix
+// as a new CMatrix.
+func (a CMatrix[C]) ConjugateTranspose() CMatrix[C] {
+	t := a.Transpose()
+	for i, val := range t.data {
+		t.data[i] = conj(val)
+	}
+
+	return t
+}
+
+// IsHermitian returns true if the CMatrix is square and each entry differs from
+// the conjugate of its mirror image across the diagonal by no more than tol.
+// The diagonal entries of a Hermitian matrix must therefore be (nearly) real.
+func (a CMatrix[C]) IsHermitian(tol float64) bool {
+	if a.rows != a.cols {
+		return false
+	}
+
+	for ci := 0; ci < a.cols; ci++ {
+		for ri := ci; ri < a.rows; ri++ {
+			z := a.data[ci*a.rows+ri] - conj(a.data[ri*a.rows+ci])
+			if cmplx.Abs(complex128(z)) > tol {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Product multiplies two complex matrices.  a is the matrix on the left, b on
+// the right.  A *DimensionError is returned if the number of columns in a does
+// not equal the number of rows in b.
+func (a CMatrix[C]) Product(b CMatrix[C]) (CMatrix[C], error) {
+	if a.cols != b.rows {
+		return CMatrix[C]{}, &DimensionError{
+			Op:       "Product",
+			Expected: Shape{Rows: a.cols, Columns: b.cols},
+			Actual:   Shape{Rows: b.rows, Columns: b.cols},
+		}
+	}
+
+	result := newCMatrix[C](a.rows, b.cols)
+	for ci := 0; ci < b.cols; ci++ {
+		col := result.col(ci)
+		for k, factor := range b.col(ci) {
+			for compIdx, val := range a.col(k) {
+				col[compIdx] += factor * val
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// MulVec returns the product Av, where A is the CMatrix.  A *DimensionError is
+// returned if v does not have one component per column of A.
+func (a CMatrix[C]) MulVec(v CVector[C]) (CVector[C], error) {
+	if len(v) != a.cols {
+		return nil, &DimensionError{
+			Op:       "MulVec",
+			Expected: Shape{Rows: a.cols, Columns: 1},
+			Actual:   Shape{Rows: len(v), Columns: 1},
+		}
+	}
+
+	result := make(CVector[C], a.rows)
+	for ci, factor := range v {
+		for ri, val := range a.col(ci) {
+			result[ri] += factor * val
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,223 @@
+package wyvern
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Eigenvalues returns the eigenvalues of the CMatrix, with multiplicity, in the
+// order in which they appear on the diagonal of its Schur form.  They are
+// computed in complex128 precision regardless of C.
+//
+// The CMatrix is reduced to upper Hessenberg form with Householder reflections
+// and then to upper triangular (complex Schur) form with the single-shift QR
+// algorithm, using Wilkinson shifts.  ErrNotSquare is returned if the CMatrix is
+// not square, and ErrNoConvergence if the QR iteration fails to isolate an
+// eigenvalue.
+func (a CMatrix[C]) Eigenvalues() ([]C, error) {
+	if a.rows != a.cols {
+		return nil, ErrNotSquare
+	}
+
+	n := a.rows
+	h := make([][]complex128, n)
+	for i := range h {
+		h[i] = make([]complex128, n)
+		for j := range h[i] {
+			h[i][j] = complex128(a.data[j*n+i])
+		}
+	}
+
+	complexHessenberg(h)
+	values, err := complexSchurValues(h)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]C, n)
+	for i, val := range values {
+		result[i] = C(val)
+	}
+
+	return result, nil
+}
+
+// complexHessenberg reduces the row-major square matrix h, in place, to upper
+// Hessenberg form by a similarity transformation with Householder reflections.
+func complexHessenberg(h [][]complex128) {
+	n := len(h)
+	for k := 0; k < n-2; k++ {
+		// Reflect h[k+1:][k] onto a multiple of the first unit vector.
+		var norm float64
+		for i := k + 1; i < n; i++ {
+			norm = math.Hypot(norm, cmplx.Abs(h[i][k]))
+		}
+		if norm == 0 {
+			continue
+		}
+
+		// Choosing alpha opposite in phase to the leading entry avoids
+		// cancellation when forming v.
+		phase := complex(1, 0)
+		if x := h[k+1][k]; x != 0 {
+			phase = x / complex(cmplx.Abs(x), 0)
+		}
+		alpha := -phase * complex(norm, 0)
+
+		v := make([]complex128, n-k-1)
+		for i := range v {
+			v[i] = h[k+1+i][k]
+		}
+		v[0] -= alpha
+
+		var vNorm float64
+		for _, val := range v {
+			vNorm = math.Hypot(vNorm, cmplx.Abs(val))
+		}
+		for i := range v {
+			v[i] /= complex(vNorm, 0)
+		}
+
+		// h = (I - 2vv*) h
+		for j := k; j < n; j++ {
+			var t complex128
+			for i, val := range v {
+				t += cmplx.Conj(val) * h[k+1+i][j]
+			}
+			for i, val := range v {
+				h[k+1+i][j] -= 2 * val * t
+			}
+		}
+
+		// h = h (I - 2vv*)
+		for i := 0; i < n; i++ {
+			var t complex128
+			for j, val := range v {
+				t += h[i][k+1+j] * val
+			}
+			for j, val := range v {
+				h[i][k+1+j] -= 2 * t * cmplx.Conj(val)
+			}
+		}
+
+		// The reflection zeroes the column below the subdiagonal exactly.
+		h[k+1][k] = alpha
+		for i := k + 2; i < n; i++ {
+			h[i][k] = 0
+		}
+	}
+}
+
+// complexSchurValues returns the eigenvalues of the upper Hessenberg matrix h,
+// which it overwrites, by the shifted QR algorithm.  Eigenvalues are deflated
+// from the bottom of the active window as its subdiagonal entries become
+// negligible.
+func complexSchurValues(h [][]complex128) ([]complex128, error) {
+	n := len(h)
+	values := make([]complex128, n)
+	eps := epsilon[float64]()
+
+	hi := n - 1
+	iterations := 0
+	for hi >= 0 {
+		// Find the start of the unreduced block ending at hi.
+		lo := hi
+		for lo > 0 {
+			scale := cmplx.Abs(h[lo-1][lo-1]) + cmplx.Abs(h[lo][lo])
+			if cmplx.Abs(h[lo][lo-1]) <= eps*scale {
+				h[lo][lo-1] = 0
+				break
+			}
+			lo--
+		}
+
+		if lo == hi {
+			values[hi] = h[hi][hi]
+			hi--
+			iterations = 0
+			continue
+		}
+
+		iterations++
+		if iterations > maxEigenIterations {
+			return nil, ErrNoConvergence
+		}
+
+		shift := wilkinsonShift(h[hi-1][hi-1], h[hi-1][hi], h[hi][hi-1], h[hi][hi])
+		if iterations%10 == 0 {
+			// An exceptional shift breaks any cycle the Wilkinson
+			// shifts have fallen into.
+			shift = h[hi][hi] + complex(cmplx.Abs(h[hi][hi-1]), 0)
+		}
+
+		qrStep(h, lo, hi, shift)
+	}
+
+	return values, nil
+}
+
+// wilkinsonShift returns the eigenvalue of the 2x2 matrix [a b; c d] closer to d.
+func wilkinsonShift(a, b, c, d complex128) complex128 {
+	mean := (a + d) / 2
+	disc := cmplx.Sqrt((a-d)*(a-d)/4 + b*c)
+	if cmplx.Abs(mean+disc-d) < cmplx.Abs(mean-disc-d) {
+		return mean + disc
+	}
+
+	return mean - disc
+}
+
+// qrStep performs one shifted QR step on rows and columns lo to hi of the upper
+// Hessenberg matrix h: h - shift*I = QR is factored with Givens rotations, and
+// replaced by RQ + shift*I, which is similar to h and remains Hessenberg.
+func qrStep(h [][]complex128, lo, hi int, shift complex128) {
+	for k := lo; k <= hi; k++ {
+		h[k][k] -= shift
+	}
+
+	type rotation struct {
+		c float64
+		s complex128
+	}
+	rotations := make([]rotation, 0, hi-lo)
+
+	// Reduce to R with rotations from the left, each zeroing one subdiagonal
+	// entry.
+	for k := lo; k < hi; k++ {
+		x, y := h[k][k], h[k+1][k]
+		r := math.Hypot(cmplx.Abs(x), cmplx.Abs(y))
+
+		g := rotation{c: 1}
+		switch {
+		case x != 0:
+			g.c = cmplx.Abs(x) / r
+			g.s = x / complex(cmplx.Abs(x), 0) * cmplx.Conj(y) / complex(r, 0)
+		case y != 0:
+			g = rotation{c: 0, s: 1}
+		}
+		rotations = append(rotations, g)
+
+		c := complex(g.c, 0)
+		for j := k; j <= hi; j++ {
+			top, bottom := h[k][j], h[k+1][j]
+			h[k][j] = c*top + g.s*bottom
+			h[k+1][j] = -cmplx.Conj(g.s)*top + c*bottom
+		}
+	}
+
+	// Form RQ by applying the conjugate transpose of each rotation on the
+	// right, in the same order.
+	for i, g := range rotations {
+		k := lo + i
+		c := complex(g.c, 0)
+		for r := lo; r <= min(k+2, hi); r++ {
+			left, right := h[r][k], h[r][k+1]
+			h[r][k] = c*left + cmplx.Conj(g.s)*right
+			h[r][k+1] = -g.s*left + c*right
+		}
+	}
+
+	for k := lo; k <= hi; k++ {
+		h[k][k] += shift
+	}
+}
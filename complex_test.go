@@ -0,0 +1,289 @@
+package wyvern_test
+
+import (
+	"math/cmplx"
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// expectSameValues asserts that actual and expected hold the same values, in any
+// order, to within tolerance.
+func expectSameValues(actual, expected []complex128, tolerance float64) {
+	ExpectWithOffset(1, actual).To(HaveLen(len(expected)))
+
+	remaining := append([]complex128{}, actual...)
+	for _, want := range expected {
+		found := -1
+		for i, got := range remaining {
+			if cmplx.Abs(got-want) <= tolerance {
+				found = i
+				break
+			}
+		}
+		ExpectWithOffset(1, found).NotTo(Equal(-1), "%v not found in %v", want, actual)
+		remaining = append(remaining[:found], remaining[found+1:]...)
+	}
+}
+
+var _ = Describe("CVector", func() {
+	var v, w wyvern.CVector[complex128]
+
+	BeforeEach(func() {
+		v = wyvern.CVector[complex128]{1 + 1i, 2}
+		w = wyvern.CVector[complex128]{3, 1i}
+	})
+
+	Describe("DotProduct", func() {
+		It("Conjugates the first vector", func() {
+			Expect(v.DotProduct(w)).To(Equal(3 - 3i + 2i))
+			Expect(v.DotProduct(v)).To(Equal(complex(6, 0)))
+		})
+
+		When("The vectors have different dimensions", func() {
+			It("Returns a DimensionError", func() {
+				_, e := v.DotProduct(wyvern.CVector[complex128]{1})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Magnitude", func() {
+		It("Returns the Euclidean length", func() {
+			Expect(wyvern.CVector[complex64]{3i, 4}.Magnitude()).To(Equal(5.0))
+		})
+	})
+
+	Describe("Conjugate", func() {
+		It("Conjugates every component", func() {
+			Expect(v.Conjugate()).To(Equal(wyvern.CVector[complex128]{1 - 1i, 2}))
+			Expect(v).To(Equal(wyvern.CVector[complex128]{1 + 1i, 2}))
+		})
+	})
+})
+
+var _ = Describe("CMatrix", func() {
+	var m wyvern.CMatrix[complex128]
+
+	BeforeEach(func() {
+		var e error
+		m, e = wyvern.CFromRows([]wyvern.CVector[complex128]{
+			{1, 2 + 1i, 3i},
+			{-1i, 4, 5},
+		})
+		Expect(e).NotTo(HaveOccurred())
+	})
+
+	Describe("Constructors", func() {
+		It("Agree between rows and columns", func() {
+			c, e := wyvern.CFromColumns(m.Columns())
+			Expect(e).NotTo(HaveOccurred())
+			Expect(c).To(Equal(m))
+			Expect(m.Row(1)).To(Equal(wyvern.CVector[complex128]{-1i, 4, 5}))
+			Expect(m.Column(2)).To(Equal(wyvern.CVector[complex128]{3i, 5}))
+			Expect(m.At(0, 1)).To(Equal(2 + 1i))
+		})
+
+		It("Converts a real Matrix", func() {
+			r := must(wyvern.FromRows([]wyvern.Vector[float64]{{1, 2}, {3, 4}}))
+			Expect(r.Complex().Rows()).To(Equal([]wyvern.CVector[complex128]{{1, 2}, {3, 4}}))
+		})
+
+		It("Converts a real Matrix to complex64", func() {
+			r := wyvern.Identity[float32](2)
+			c := wyvern.CFromMatrix[complex64](r)
+			Expect(c.Rows()).To(Equal([]wyvern.CVector[complex64]{{1, 0}, {0, 1}}))
+		})
+
+		When("The vectors have different dimensions", func() {
+			It("Returns a DimensionError", func() {
+				_, e := wyvern.CFromRows([]wyvern.CVector[complex128]{{1, 2}, {3}})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+
+		When("An index is out of range", func() {
+			It("Returns an IndexError", func() {
+				_, e := m.At(2, 0)
+				Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+			})
+		})
+	})
+
+	Describe("Transpose and ConjugateTranspose", func() {
+		It("Transposes without conjugating", func() {
+			Expect(m.Transpose().Rows()).To(Equal(m.Columns()))
+		})
+
+		It("Conjugates the transposed entries", func() {
+			Expect(m.ConjugateTranspose().Rows()).To(Equal([]wyvern.CVector[complex128]{
+				{1, 1i},
+				{2 - 1i, 4},
+				{-3i, 5},
+			}))
+		})
+	})
+
+	Describe("IsHermitian", func() {
+		It("Recognizes Hermitian matrices", func() {
+			h, _ := wyvern.CFromRows([]wyvern.CVector[complex128]{
+				{2, 1 - 1i},
+				{1 + 1i, 3},
+			})
+			Expect(h.IsHermitian(0)).To(BeTrue())
+			Expect(h.Transpose().IsHermitian(0)).To(BeTrue())
+		})
+
+		It("Rejects symmetric complex matrices which are not Hermitian", func() {
+			s, _ := wyvern.CFromRows([]wyvern.CVector[complex128]{
+				{2, 1i},
+				{1i, 3},
+			})
+			Expect(s.IsHermitian(1e-12)).To(BeFalse())
+		})
+
+		It("Requires a real diagonal", func() {
+			d, _ := wyvern.CFromRows([]wyvern.CVector[complex128]{{1i}})
+			Expect(d.IsHermitian(1e-12)).To(BeFalse())
+		})
+
+		It("Rejects non-square matrices", func() {
+			Expect(m.IsHermitian(1)).To(BeFalse())
+		})
+	})
+
+	Describe("Product and MulVec", func() {
+		It("Multiplies complex matrices", func() {
+			p, e := m.Product(m.ConjugateTranspose())
+			Expect(e).NotTo(HaveOccurred())
+			Expect(p.IsHermitian(1e-12)).To(BeTrue())
+			Expect(p.At(0, 0)).To(Equal(complex(1+5+9, 0)))
+		})
+
+		It("Multiplies a complex vector", func() {
+			Expect(m.MulVec(wyvern.CVector[complex128]{1, 0, 1i})).To(Equal(wyvern.CVector[complex128]{-2, 5i - 1i}))
+		})
+
+		When("The dimensions are incompatible", func() {
+			It("Returns a DimensionError", func() {
+				_, e := m.Product(m)
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+
+				_, e = m.MulVec(wyvern.CVector[complex128]{1, 2})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Eigenvalues", func() {
+		It("Finds the complex eigenvalues of a real rotation", func() {
+			r := must(wyvern.FromRows([]wyvern.Vector[float64]{{0, -1}, {1, 0}})).Complex()
+			values, e := r.Eigenvalues()
+			Expect(e).NotTo(HaveOccurred())
+			expectSameValues(values, []complex128{-1i, 1i}, 1e-12)
+		})
+
+		It("Finds real eigenvalues for a Hermitian matrix", func() {
+			h, _ := wyvern.CFromRows([]wyvern.CVector[complex128]{
+				{2, 1 - 1i, 0},
+				{1 + 1i, 3, 2i},
+				{0, -2i, 1},
+			})
+			values, e := h.Eigenvalues()
+			Expect(e).NotTo(HaveOccurred())
+			for _, val := range values {
+				Expect(imag(val)).To(BeNumerically("~", 0, 1e-10))
+			}
+
+			// The sum of the eigenvalues is the trace.
+			var sum complex128
+			for _, val := range values {
+				sum += val
+			}
+			Expect(cmplx.Abs(sum - 6)).To(BeNumerically("<", 1e-10))
+		})
+
+		It("Returns eigenvalues whose eigenvectors exist", func() {
+			rng := rand.New(rand.NewSource(3))
+			n := 6
+			rows := make([]wyvern.CVector[complex128], n)
+			for i := range rows {
+				rows[i] = make(wyvern.CVector[complex128], n)
+				for j := range rows[i] {
+					rows[i][j] = complex(rng.NormFloat64(), rng.NormFloat64())
+				}
+			}
+			a, _ := wyvern.CFromRows(rows)
+
+			values, e := a.Eigenvalues()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(values).To(HaveLen(n))
+
+			// A - λI is singular for each eigenvalue λ.
+			for _, val := range values {
+				shifted := make([]wyvern.CVector[complex128], n)
+				for i := range shifted {
+					shifted[i] = append(wyvern.CVector[complex128]{}, rows[i]...)
+					shifted[i][i] -= val
+				}
+				Expect(cmplx.Abs(determinant(shifted))).To(BeNumerically("<", 1e-8))
+			}
+		})
+
+		It("Agrees with Eigen for a real Matrix", func() {
+			r := wyvern.Random[float64](5, 5, rand.New(rand.NewSource(11)))
+			eig, e := r.Eigen()
+			Expect(e).NotTo(HaveOccurred())
+
+			values, e := r.Complex().Eigenvalues()
+			Expect(e).NotTo(HaveOccurred())
+			expectSameValues(values, eig.Values(), 1e-9)
+		})
+
+		It("Works in complex64", func() {
+			c, _ := wyvern.CFromRows([]wyvern.CVector[complex64]{{1i, 0}, {0, 2}})
+			values, e := c.Eigenvalues()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(values).To(ConsistOf(complex64(1i), complex64(2)))
+		})
+
+		When("The matrix is not square", func() {
+			It("Returns ErrNotSquare", func() {
+				_, e := m.Eigenvalues()
+				Expect(e).To(MatchError(wyvern.ErrNotSquare))
+			})
+		})
+	})
+})
+
+// determinant returns the determinant of the square matrix with the given rows,
+// by Gaussian elimination with partial pivoting.  The rows are overwritten.
+func determinant(rows []wyvern.CVector[complex128]) complex128 {
+	det := complex(1, 0)
+	for k := range rows {
+		p := k
+		for i := k + 1; i < len(rows); i++ {
+			if cmplx.Abs(rows[i][k]) > cmplx.Abs(rows[p][k]) {
+				p = i
+			}
+		}
+		if p != k {
+			rows[p], rows[k] = rows[k], rows[p]
+			det = -det
+		}
+		if rows[k][k] == 0 {
+			return 0
+		}
+		det *= rows[k][k]
+		for i := k + 1; i < len(rows); i++ {
+			f := rows[i][k] / rows[k][k]
+			for j := k; j < len(rows); j++ {
+				rows[i][j] -= f * rows[k][j]
+			}
+		}
+	}
+	return det
+}
@@ -0,0 +1,87 @@
+package wyvern
+
+import (
+	"math/rand"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A Distribution draws a random value from rng.
+type Distribution func(rng *rand.Rand) float64
+
+// Uniform returns a Distribution which draws values uniformly from [lo, hi).
+func Uniform(lo, hi float64) Distribution {
+	return func(rng *rand.Rand) float64 {
+		return lo + (hi-lo)*rng.Float64()
+	}
+}
+
+// Normal returns a Distribution which draws values from the normal distribution
+// with the given mean and standard deviation.
+func Normal(mean, stddev float64) Distribution {
+	return func(rng *rand.Rand) float64 {
+		return mean + stddev*rng.NormFloat64()
+	}
+}
+
+// Identity returns the n x n identity matrix.
+func Identity[N constraints.Float](n int) Matrix[N] {
+	m := Zeros[N](n, n)
+	for i := 0; i < n; i++ {
+		m.data[i*m.stride+i] = 1
+	}
+
+	return m
+}
+
+// Zeros returns a Matrix with the specified numbers of rows and columns, with
+// every entry zero.
+func Zeros[N constraints.Float](rows, cols int) Matrix[N] {
+	return newMatrix[N](rows, cols)
+}
+
+// Ones returns a Matrix with the specified numbers of rows and columns, with
+// every entry one.
+func Ones[N constraints.Float](rows, cols int) Matrix[N] {
+	return Filled[N](rows, cols, 1)
+}
+
+// Filled returns a Matrix with the specified numbers of rows and columns, with
+// every entry set to val.
+func Filled[N constraints.Float](rows, cols int, val N) Matrix[N] {
+	m := Zeros[N](rows, cols)
+	for i := range m.data {
+		m.data[i] = val
+	}
+
+	return m
+}
+
+// Diagonal returns the square Matrix with the components of v along its main
+// diagonal and zeros elsewhere.
+func Diagonal[N constraints.Float](v Vector[N]) Matrix[N] {
+	m := Zeros[N](len(v), len(v))
+	for i, val := range v {
+		m.data[i*m.stride+i] = val
+	}
+
+	return m
+}
+
+// Random returns a Matrix with the specified numbers of rows and columns, with
+// entries drawn uniformly from [0, 1) using rng.  Seeding rng makes the result
+// reproducible.
+func Random[N constraints.Float](rows, cols int, rng *rand.Rand) Matrix[N] {
+	return RandomFrom[N](rows, cols, rng, Uniform(0, 1))
+}
+
+// RandomFrom returns a Matrix with the specified numbers of rows and columns,
+// with entries drawn from dist using rng.  Entries are drawn column by column.
+func RandomFrom[N constraints.Float](rows, cols int, rng *rand.Rand, dist Distribution) Matrix[N] {
+	m := Zeros[N](rows, cols)
+	for i := range m.data {
+		m.data[i] = N(dist(rng))
+	}
+
+	return m
+}
@@ -0,0 +1,106 @@
+package wyvern_test
+
+import (
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+var _ = Describe("Constructors", func() {
+	Describe("Identity", func() {
+		It("Returns the identity matrix", func() {
+			Expect(wyvern.Identity[float64](3).Rows()).To(Equal([]wyvern.Vector[float64]{
+				{1, 0, 0},
+				{0, 1, 0},
+				{0, 0, 1},
+			}))
+		})
+	})
+
+	Describe("Zeros", func() {
+		It("Returns a matrix of zeros with the requested shape", func() {
+			Expect(wyvern.Zeros[float64](2, 3).Rows()).To(Equal([]wyvern.Vector[float64]{
+				{0, 0, 0},
+				{0, 0, 0},
+			}))
+		})
+	})
+
+	Describe("Ones", func() {
+		It("Returns a matrix of ones with the requested shape", func() {
+			Expect(wyvern.Ones[float32](3, 2).Rows()).To(Equal([]wyvern.Vector[float32]{
+				{1, 1},
+				{1, 1},
+				{1, 1},
+			}))
+		})
+	})
+
+	Describe("Filled", func() {
+		It("Returns a matrix with every entry set to the value", func() {
+			Expect(wyvern.Filled(2, 2, 7.5).Rows()).To(Equal([]wyvern.Vector[float64]{
+				{7.5, 7.5},
+				{7.5, 7.5},
+			}))
+		})
+	})
+
+	Describe("Diagonal", func() {
+		It("Returns a square matrix with the vector along the diagonal", func() {
+			Expect(wyvern.Diagonal(wyvern.Vector[float64]{2, -1, 4}).Rows()).To(Equal([]wyvern.Vector[float64]{
+				{2, 0, 0},
+				{0, -1, 0},
+				{0, 0, 4},
+			}))
+		})
+	})
+
+	Describe("Random", func() {
+		It("Returns entries in [0, 1)", func() {
+			m := wyvern.Random[float64](4, 5, rand.New(rand.NewSource(1)))
+			Expect(m.Rows()).To(HaveLen(4))
+			Expect(m.Columns()).To(HaveLen(5))
+			for _, c := range m.Columns() {
+				for _, val := range c {
+					Expect(val).To(BeNumerically(">=", 0))
+					Expect(val).To(BeNumerically("<", 1))
+				}
+			}
+		})
+
+		It("Is reproducible from the same seed", func() {
+			m1 := wyvern.Random[float64](3, 3, rand.New(rand.NewSource(42)))
+			m2 := wyvern.Random[float64](3, 3, rand.New(rand.NewSource(42)))
+			m3 := wyvern.Random[float64](3, 3, rand.New(rand.NewSource(43)))
+			Expect(m1).To(Equal(m2))
+			Expect(m1).NotTo(Equal(m3))
+		})
+	})
+
+	Describe("RandomFrom", func() {
+		It("Draws entries from the distribution", func() {
+			m := wyvern.RandomFrom[float64](10, 10, rand.New(rand.NewSource(7)), wyvern.Uniform(-3, -2))
+			for _, c := range m.Columns() {
+				for _, val := range c {
+					Expect(val).To(BeNumerically(">=", -3))
+					Expect(val).To(BeNumerically("<", -2))
+				}
+			}
+		})
+
+		It("Supports normally distributed entries", func() {
+			m := wyvern.RandomFrom[float64](100, 100, rand.New(rand.NewSource(7)), wyvern.Normal(5, 2))
+
+			var sum float64
+			for _, c := range m.Columns() {
+				for _, val := range c {
+					sum += val
+				}
+			}
+			Expect(sum / 10000).To(BeNumerically("~", 5, 0.1))
+		})
+	})
+})
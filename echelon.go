@@ -0,0 +1,81 @@
+package wyvern
+
+// RowEchelon reduces a copy of the Matrix to row echelon form using Gaussian
+// elimination with partial pivoting - at each step the row with the largest
+// magnitude entry in the pivot column is swapped into place.  It returns the
+// reduced Matrix along with the indices of the pivot columns, in order.  The
+// original Matrix is not modified.
+//
+// Entries whose magnitude is negligible relative to the largest entry in the
+// Matrix are treated as zero when selecting pivots.
+func (a Matrix[N]) RowEchelon() (Matrix[N], []int) {
+	r := a.Clone()
+	pivots := r.forwardEliminate(r.cols, a.pivotTolerance())
+	return r, pivots
+}
+
+// ReducedRowEchelon reduces a copy of the Matrix to reduced row echelon form:
+// each pivot is 1 and is the only nonzero entry in its column.  It returns the
+// reduced Matrix along with the indices of the pivot columns, in order.  The
+// original Matrix is not modified.
+func (a Matrix[N]) ReducedRowEchelon() (Matrix[N], []int) {
+	r, pivots := a.RowEchelon()
+	r.backEliminate(pivots)
+	return r, pivots
+}
+
+// forwardEliminate reduces the Matrix, in place, to row echelon form and returns
+// the pivot columns.  Only the first pivotColumns columns are searched for pivots;
+// any remaining columns (e.g. the right-hand sides of an augmented matrix) are
+// carried along by the row operations.  Candidate pivots with magnitude at or
+// below tol are treated as zero.
+func (a Matrix[N]) forwardEliminate(pivotColumns int, tol N) []int {
+	rows, _ := a.Dims()
+	cols := pivotColumns
+	pivots := make([]int, 0, min(rows, cols))
+
+	pivotRow := 0
+	for pc := 0; pc < cols && pivotRow < rows; pc++ {
+		col := a.col(pc)
+
+		best := pivotRow
+		for ri := pivotRow + 1; ri < rows; ri++ {
+			if abs(col[ri]) > abs(col[best]) {
+				best = ri
+			}
+		}
+
+		if abs(col[best]) <= tol {
+			// No usable pivot in this column - flush the residue so the
+			// result is a true echelon form.
+			for ri := pivotRow; ri < rows; ri++ {
+				col[ri] = 0
+			}
+			continue
+		}
+
+		a.swapRows(pivotRow, best)
+		for ri := pivotRow + 1; ri < rows; ri++ {
+			a.eliminate(pivotRow, ri, pc)
+		}
+
+		pivots = append(pivots, pc)
+		pivotRow++
+	}
+
+	return pivots
+}
+
+// backEliminate takes a Matrix in row echelon form with the given pivot columns
+// and, in place, scales each pivot to 1 and clears the entries above it.
+func (a Matrix[N]) backEliminate(pivots []int) {
+	for pr := len(pivots) - 1; pr >= 0; pr-- {
+		pc := pivots[pr]
+		a.MultiplyRow(pr, 1/a.col(pc)[pr])
+		a.col(pc)[pr] = 1
+
+		for ri := 0; ri < pr; ri++ {
+			a.eliminate(pr, ri, pc)
+		}
+	}
+}
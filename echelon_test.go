@@ -0,0 +1,165 @@
+package wyvern_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+var _ = Describe("Echelon forms", func() {
+	var (
+		mt   wyvern.Matrix[float64]
+		rows []wyvern.Vector[float64]
+	)
+
+	JustBeforeEach(func() {
+		mt, _ = wyvern.FromRows(rows)
+	})
+
+	Describe("RowEchelon", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 2},
+				{3, 4},
+			}
+		})
+
+		It("Uses the largest available pivot in each column", func() {
+			ref, pivots := mt.RowEchelon()
+			Expect(pivots).To(Equal([]int{0, 1}))
+			expectVectorsNear(ref.Rows(), []wyvern.Vector[float64]{
+				{3, 4},
+				{0, 2.0 / 3.0},
+			}, 1e-12)
+		})
+
+		It("Leaves the original matrix unchanged", func() {
+			mt.RowEchelon()
+			Expect(mt.Rows()).To(Equal(rows))
+		})
+
+		When("The matrix is rectangular", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2, 3, 4},
+					{2, 4, 7, 9},
+				}
+			})
+
+			It("Skips columns without a pivot", func() {
+				ref, pivots := mt.RowEchelon()
+				Expect(pivots).To(Equal([]int{0, 2}))
+				expectVectorsNear(ref.Rows(), []wyvern.Vector[float64]{
+					{2, 4, 7, 9},
+					{0, 0, -0.5, -0.5},
+				}, 1e-12)
+			})
+		})
+
+		When("The matrix contains NaN", func() {
+			It("Rejects only exact zeros as pivots", func() {
+				mt, _ = wyvern.FromRows([]wyvern.Vector[float64]{
+					{1, 1, math.NaN()},
+					{1, 1, 0},
+				})
+				_, pivots := mt.RowEchelon()
+				Expect(pivots).To(Equal([]int{0, 2}))
+
+				// 0.1 - (1/3)(0.3) is roundoff, which would be negligible
+				// against the scale of a matrix without the NaN.
+				mt, _ = wyvern.FromRows([]wyvern.Vector[float64]{
+					{3, 0.3, math.NaN()},
+					{1, 0.1, 0},
+				})
+				_, pivots = mt.RowEchelon()
+				Expect(pivots).To(Equal([]int{0, 1}))
+			})
+		})
+	})
+
+	Describe("ReducedRowEchelon", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{2, 1, -1},
+				{-3, -1, 2},
+				{-2, 1, 2},
+			}
+		})
+
+		When("The matrix is invertible", func() {
+			It("Reduces the matrix to the identity", func() {
+				rref, pivots := mt.ReducedRowEchelon()
+				Expect(pivots).To(Equal([]int{0, 1, 2}))
+				expectVectorsNear(rref.Rows(), []wyvern.Vector[float64]{
+					{1, 0, 0},
+					{0, 1, 0},
+					{0, 0, 1},
+				}, 1e-12)
+			})
+
+			It("Leaves the original matrix unchanged", func() {
+				mt.ReducedRowEchelon()
+				Expect(mt.Rows()).To(Equal(rows))
+			})
+		})
+
+		When("The matrix is singular", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2, -1},
+					{2, 1, 1},
+					{1, -1, 2},
+				}
+			})
+
+			It("Returns the pivot columns and a zero row", func() {
+				rref, pivots := mt.ReducedRowEchelon()
+				Expect(pivots).To(Equal([]int{0, 1}))
+				expectVectorsNear(rref.Rows(), []wyvern.Vector[float64]{
+					{1, 0, 1},
+					{0, 1, -1},
+					{0, 0, 0},
+				}, 1e-12)
+			})
+		})
+
+		When("The matrix is rectangular", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2, 3, 4},
+					{2, 4, 7, 9},
+				}
+			})
+
+			It("Returns the reduced form", func() {
+				rref, pivots := mt.ReducedRowEchelon()
+				Expect(pivots).To(Equal([]int{0, 2}))
+				expectVectorsNear(rref.Rows(), []wyvern.Vector[float64]{
+					{1, 2, 0, 1},
+					{0, 0, 1, 1},
+				}, 1e-12)
+			})
+		})
+
+		When("The first column is zero", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{0, 1},
+					{0, 2},
+				}
+			})
+
+			It("Starts with the first nonzero column", func() {
+				rref, pivots := mt.ReducedRowEchelon()
+				Expect(pivots).To(Equal([]int{1}))
+				Expect(rref.Rows()).To(Equal([]wyvern.Vector[float64]{
+					{0, 1},
+					{0, 0},
+				}))
+			})
+		})
+	})
+})
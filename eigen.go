@@ -0,0 +1,905 @@
+package wyvern
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// maxEigenIterations bounds the number of QR (or QL) sweeps spent isolating any
+// single eigenvalue before giving up with ErrNoConvergence.
+const maxEigenIterations = 100
+
+// Eigen is the eigendecomposition of a square Matrix.  The eigenvalues and
+// eigenvectors are computed in the precision of N; the eigenvalues are returned
+// as complex128.
+type Eigen[N constraints.Float] struct {
+	values    []complex128
+	vectors   Matrix[N]
+	symmetric bool
+}
+
+// Eigen computes the eigenvalues and eigenvectors of the Matrix.
+//
+// A symmetric Matrix is reduced to tridiagonal form with Householder
+// transformations and then diagonalized with the implicit QL algorithm; its
+// eigenvalues are real, returned in ascending order, and its eigenvectors are
+// orthonormal.  Any other Matrix is reduced to upper Hessenberg form and then to
+// real Schur form with the Francis double-shift QR algorithm; complex
+// eigenvalues appear as adjacent conjugate pairs.
+//
+// ErrNotSquare is returned if the Matrix is not square, and ErrNoConvergence if
+// the QR iteration fails to isolate an eigenvalue.
+func (a Matrix[N]) Eigen() (*Eigen[N], error) {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return nil, ErrNotSquare
+	}
+
+	es := newEigenSolver(a)
+
+	// Rounding in whatever produced the Matrix can leave it unsymmetric in
+	// the last bits, so symmetry is judged relative to its largest entry.
+	symmetric := a.IsSymmetric(a.pivotTolerance())
+
+	var err error
+	if symmetric {
+		es.tridiagonalize()
+		err = es.diagonalize()
+	} else {
+		es.reduceToHessenberg()
+		err = es.reduceToSchur()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]complex128, rows)
+	for i := range values {
+		values[i] = complex(float64(es.d[i]), float64(es.e[i]))
+	}
+
+	if !symmetric {
+		es.normalizeVectors()
+	}
+
+	return &Eigen[N]{
+		values:    values,
+		vectors:   es.vectors,
+		symmetric: symmetric,
+	}, nil
+}
+
+// PowerIteration estimates the dominant eigenvalue of the Matrix (the one with
+// the largest magnitude) and a corresponding unit eigenvector, by repeatedly
+// multiplying start by the Matrix and normalizing.  Only matrix-vector products
+// are needed, so this is suited to large matrices where only the dominant
+// eigenpair is of interest.  If start is nil, a fixed starting vector is used.
+//
+// Iteration stops once the residual |Av - lv| is no more than tol times |l|.  If
+// that does not happen within maxIterations, the latest estimates are returned
+// along with ErrNoConvergence.  Convergence requires a single eigenvalue of
+// largest magnitude; in particular it fails for a dominant complex pair.
+func (a Matrix[N]) PowerIteration(start Vector[N], maxIterations int, tol N) (N, Vector[N], error) {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return 0, nil, ErrNotSquare
+	}
+
+	if start == nil {
+		start = make(Vector[N], cols)
+		for i := range start {
+			start[i] = 1 + N(i)/N(cols)
+		}
+	}
+
+	if len(start) != cols {
+		return 0, nil, &DimensionError{
+			Op:       "PowerIteration",
+			Expected: Shape{Rows: cols, Columns: 1},
+			Actual:   Shape{Rows: len(start), Columns: 1},
+		}
+	}
+
+	norm := N(start.Magnitude())
+	if norm == 0 {
+		return 0, nil, ErrZeroVector
+	}
+	v := append(Vector[N]{}, start...).Multiply(1 / norm)
+
+	var lambda N
+	for iter := 0; iter < maxIterations; iter++ {
+		w := make(Vector[N], len(v))
+		a.mulVecTo(w, v)
+		lambda = v.dot(w)
+
+		residual := N(w.difference(append(Vector[N]{}, v...).Multiply(lambda)).Magnitude())
+
+		norm = N(w.Magnitude())
+		if norm == 0 {
+			// v lies in the null space, so it is an eigenvector for 0.
+			return 0, v, nil
+		}
+		w.Multiply(1 / norm)
+
+		if residual <= tol*abs(lambda) {
+			return lambda, v, nil
+		}
+
+		v = w
+	}
+
+	return lambda, v, ErrNoConvergence
+}
+
+// Values returns the eigenvalues.  For a symmetric Matrix every imaginary part
+// is zero.
+func (e *Eigen[N]) Values() []complex128 {
+	return append([]complex128{}, e.values...)
+}
+
+// RealValues returns the real parts of the eigenvalues, which for a symmetric
+// Matrix are the eigenvalues themselves.
+func (e *Eigen[N]) RealValues() Vector[N] {
+	d := make(Vector[N], len(e.values))
+	for i, val := range e.values {
+		d[i] = N(real(val))
+	}
+
+	return d
+}
+
+// Vectors returns the eigenvectors as the columns of a Matrix, in the same order
+// as Values.  A real eigenvalue's column is its eigenvector, scaled to unit length.
+// For a complex conjugate pair at positions j and j+1, columns j and j+1 hold the
+// real and imaginary parts of the eigenvector for the eigenvalue at j; the
+// eigenvector for its conjugate is the conjugate of that vector.
+func (e *Eigen[N]) Vectors() Matrix[N] {
+	return e.vectors.Clone()
+}
+
+// IsSymmetric returns true if the decomposed Matrix was symmetric.
+func (e *Eigen[N]) IsSymmetric() bool {
+	return e.symmetric
+}
+
+// eigenSolver holds the working state for the eigenvalue algorithms, which are
+// adapted from the EISPACK routines tred2, tql2, orthes and hqr2 (by way of the
+// public domain JAMA library).  v and h hold the columns of n x n matrices as
+// views of their column-major storage, so entry (i, j) is v[i][j], and the
+// loops run down columns wherever the algorithms allow.
+type eigenSolver[N constraints.Float] struct {
+	n int
+	// d and e hold the real and imaginary parts of the eigenvalues.  During
+	// the symmetric reduction they hold the diagonal and subdiagonal.
+	d, e []N
+	// v accumulates the transformations, and finally holds the
+	// eigenvectors; vectors is the Matrix whose columns it views.
+	v       []Vector[N]
+	vectors Matrix[N]
+	h       []Vector[N]
+}
+
+func newEigenSolver[N constraints.Float](a Matrix[N]) *eigenSolver[N] {
+	vectors := a.Clone()
+	return &eigenSolver[N]{
+		n:       a.cols,
+		d:       make([]N, a.cols),
+		e:       make([]N, a.cols),
+		v:       vectors.columnViews(),
+		vectors: vectors,
+		h:       a.Clone().columnViews(),
+	}
+}
+
+// tridiagonalize reduces the symmetric matrix held in v to tridiagonal form with
+// Householder transformations, accumulating the transformations in v.
+func (es *eigenSolver[N]) tridiagonalize() {
+	n, d, e, v := es.n, es.d, es.e, es.v
+	if n == 0 {
+		return
+	}
+
+	for j := 0; j < n; j++ {
+		d[j] = v[j][n-1]
+	}
+
+	for i := n - 1; i > 0; i-- {
+		var scale, h N
+		for k := 0; k < i; k++ {
+			scale += abs(d[k])
+		}
+
+		if scale == 0 {
+			e[i] = d[i-1]
+			for j := 0; j < i; j++ {
+				d[j] = v[j][i-1]
+				v[j][i] = 0
+				v[i][j] = 0
+			}
+		} else {
+			for k := 0; k < i; k++ {
+				d[k] /= scale
+				h += d[k] * d[k]
+			}
+
+			f := d[i-1]
+			g := sqrt(h)
+			if f > 0 {
+				g = -g
+			}
+			e[i] = scale * g
+			h -= f * g
+			d[i-1] = f - g
+			for j := 0; j < i; j++ {
+				e[j] = 0
+			}
+
+			for j := 0; j < i; j++ {
+				f = d[j]
+				v[i][j] = f
+				g = e[j] + v[j][j]*f
+				for k := j + 1; k <= i-1; k++ {
+					g += v[j][k] * d[k]
+					e[k] += v[j][k] * f
+				}
+				e[j] = g
+			}
+
+			f = 0
+			for j := 0; j < i; j++ {
+				e[j] /= h
+				f += e[j] * d[j]
+			}
+
+			hh := f / (h + h)
+			for j := 0; j < i; j++ {
+				e[j] -= hh * d[j]
+			}
+
+			for j := 0; j < i; j++ {
+				f = d[j]
+				g = e[j]
+				for k := j; k <= i-1; k++ {
+					v[j][k] -= f*e[k] + g*d[k]
+				}
+				d[j] = v[j][i-1]
+				v[j][i] = 0
+			}
+		}
+		d[i] = h
+	}
+
+	// Accumulate the transformations.
+	for i := 0; i < n-1; i++ {
+		v[i][n-1] = v[i][i]
+		v[i][i] = 1
+		h := d[i+1]
+		if h != 0 {
+			for k := 0; k <= i; k++ {
+				d[k] = v[i+1][k] / h
+			}
+			for j := 0; j <= i; j++ {
+				var g N
+				for k := 0; k <= i; k++ {
+					g += v[i+1][k] * v[j][k]
+				}
+				for k := 0; k <= i; k++ {
+					v[j][k] -= g * d[k]
+				}
+			}
+		}
+		for k := 0; k <= i; k++ {
+			v[i+1][k] = 0
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		d[j] = v[j][n-1]
+		v[j][n-1] = 0
+	}
+	v[n-1][n-1] = 1
+	e[0] = 0
+}
+
+// diagonalize finds the eigenvalues and eigenvectors of the symmetric
+// tridiagonal matrix produced by tridiagonalize, using the implicit QL
+// algorithm, and sorts them into ascending order of eigenvalue.
+func (es *eigenSolver[N]) diagonalize() error {
+	n, d, e, v := es.n, es.d, es.e, es.v
+	if n == 0 {
+		return nil
+	}
+
+	for i := 1; i < n; i++ {
+		e[i-1] = e[i]
+	}
+	e[n-1] = 0
+
+	var f, tst1 N
+	eps := epsilon[N]()
+	for l := 0; l < n; l++ {
+		// Find a small subdiagonal element.
+		tst1 = max(tst1, abs(d[l])+abs(e[l]))
+		m := l
+		for m < n-1 && abs(e[m]) > eps*tst1 {
+			m++
+		}
+
+		// If m == l, d[l] is already an eigenvalue; otherwise iterate.
+		if m > l {
+			for iter := 0; ; iter++ {
+				if iter == maxEigenIterations {
+					return ErrNoConvergence
+				}
+
+				// Compute the implicit shift.
+				g := d[l]
+				p := (d[l+1] - g) / (2 * e[l])
+				r := hypot(p, 1)
+				if p < 0 {
+					r = -r
+				}
+				d[l] = e[l] / (p + r)
+				d[l+1] = e[l] * (p + r)
+				dl1 := d[l+1]
+				h := g - d[l]
+				for i := l + 2; i < n; i++ {
+					d[i] -= h
+				}
+				f += h
+
+				// Implicit QL transformation.
+				p = d[m]
+				var c, c2, c3 N = 1, 1, 1
+				el1 := e[l+1]
+				var s, s2 N
+				for i := m - 1; i >= l; i-- {
+					c3 = c2
+					c2 = c
+					s2 = s
+					g = c * e[i]
+					h = c * p
+					r = hypot(p, e[i])
+					e[i+1] = s * r
+					s = e[i] / r
+					c = p / r
+					p = c*d[i] - s*g
+					d[i+1] = h + s*(c*g+s*d[i])
+
+					for k := 0; k < n; k++ {
+						h = v[i+1][k]
+						v[i+1][k] = s*v[i][k] + c*h
+						v[i][k] = c*v[i][k] - s*h
+					}
+				}
+				p = -s * s2 * c3 * el1 * e[l] / dl1
+				e[l] = s * p
+				d[l] = c * p
+
+				if abs(e[l]) <= eps*tst1 {
+					break
+				}
+			}
+		}
+		d[l] += f
+		e[l] = 0
+	}
+
+	// Selection sort, carrying the eigenvectors along.
+	for i := 0; i < n-1; i++ {
+		k := i
+		p := d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] < p {
+				k = j
+				p = d[j]
+			}
+		}
+		if k != i {
+			d[k] = d[i]
+			d[i] = p
+			for j := 0; j < n; j++ {
+				v[i][j], v[k][j] = v[k][j], v[i][j]
+			}
+		}
+	}
+
+	return nil
+}
+
+// reduceToHessenberg reduces the general matrix held in h to upper Hessenberg
+// form with Householder similarity transformations, accumulating the
+// transformations in v.
+func (es *eigenSolver[N]) reduceToHessenberg() {
+	n, h, v := es.n, es.h, es.v
+	high := n - 1
+	ort := make([]N, n)
+
+	for m := 1; m <= high-1; m++ {
+		var scale N
+		for i := m; i <= high; i++ {
+			scale += abs(h[m-1][i])
+		}
+
+		if scale != 0 {
+			var hh N
+			for i := high; i >= m; i-- {
+				ort[i] = h[m-1][i] / scale
+				hh += ort[i] * ort[i]
+			}
+			g := sqrt(hh)
+			if ort[m] > 0 {
+				g = -g
+			}
+			hh -= ort[m] * g
+			ort[m] -= g
+
+			// Apply the transformation: H = (I - u u'/h) H (I - u u'/h)
+			for j := m; j < n; j++ {
+				var f N
+				for i := high; i >= m; i-- {
+					f += ort[i] * h[j][i]
+				}
+				f /= hh
+				for i := m; i <= high; i++ {
+					h[j][i] -= f * ort[i]
+				}
+			}
+
+			for i := 0; i <= high; i++ {
+				var f N
+				for j := high; j >= m; j-- {
+					f += ort[j] * h[j][i]
+				}
+				f /= hh
+				for j := m; j <= high; j++ {
+					h[j][i] -= f * ort[j]
+				}
+			}
+			ort[m] *= scale
+			h[m-1][m] = scale * g
+		}
+	}
+
+	// Accumulate the transformations.
+	for i := range v {
+		for j := range v[i] {
+			v[j][i] = 0
+		}
+		v[i][i] = 1
+	}
+
+	for m := high - 1; m >= 1; m-- {
+		if h[m-1][m] != 0 {
+			for i := m + 1; i <= high; i++ {
+				ort[i] = h[m-1][i]
+			}
+			for j := m; j <= high; j++ {
+				var g N
+				for i := m; i <= high; i++ {
+					g += ort[i] * v[j][i]
+				}
+				// Double division avoids possible underflow.
+				g = (g / ort[m]) / h[m-1][m]
+				for i := m; i <= high; i++ {
+					v[j][i] += g * ort[i]
+				}
+			}
+		}
+	}
+}
+
+// reduceToSchur reduces the upper Hessenberg matrix in h to real Schur form with
+// the shifted QR algorithm, then back substitutes to find the eigenvectors.
+func (es *eigenSolver[N]) reduceToSchur() error {
+	nn, d, e, h, v := es.n, es.d, es.e, es.h, es.v
+	n := nn - 1
+	eps := epsilon[N]()
+	var exshift, p, q, r, s, z, t, w, x, y N
+
+	var norm N
+	for i := 0; i < nn; i++ {
+		for j := max(i-1, 0); j < nn; j++ {
+			norm += abs(h[j][i])
+		}
+	}
+
+	iter := 0
+	for n >= 0 {
+		// Look for a single small subdiagonal element.
+		l := n
+		for l > 0 {
+			s = abs(h[l-1][l-1]) + abs(h[l][l])
+			if s == 0 {
+				s = norm
+			}
+			if abs(h[l-1][l]) < eps*s {
+				break
+			}
+			l--
+		}
+
+		if l == n {
+			// One root found.
+			h[n][n] += exshift
+			d[n] = h[n][n]
+			e[n] = 0
+			n--
+			iter = 0
+		} else if l == n-1 {
+			// Two roots found.
+			w = h[n-1][n] * h[n][n-1]
+			p = (h[n-1][n-1] - h[n][n]) / 2
+			q = p*p + w
+			z = sqrt(abs(q))
+			h[n][n] += exshift
+			h[n-1][n-1] += exshift
+			x = h[n][n]
+
+			if q >= 0 {
+				// Real pair.
+				if p >= 0 {
+					z = p + z
+				} else {
+					z = p - z
+				}
+				d[n-1] = x + z
+				d[n] = d[n-1]
+				if z != 0 {
+					d[n] = x - w/z
+				}
+				e[n-1] = 0
+				e[n] = 0
+				x = h[n-1][n]
+				s = abs(x) + abs(z)
+				p = x / s
+				q = z / s
+				r = sqrt(p*p + q*q)
+				p /= r
+				q /= r
+
+				for j := n - 1; j < nn; j++ {
+					z = h[j][n-1]
+					h[j][n-1] = q*z + p*h[j][n]
+					h[j][n] = q*h[j][n] - p*z
+				}
+
+				for i := 0; i <= n; i++ {
+					z = h[n-1][i]
+					h[n-1][i] = q*z + p*h[n][i]
+					h[n][i] = q*h[n][i] - p*z
+				}
+
+				for i := 0; i < nn; i++ {
+					z = v[n-1][i]
+					v[n-1][i] = q*z + p*v[n][i]
+					v[n][i] = q*v[n][i] - p*z
+				}
+			} else {
+				// Complex pair.
+				d[n-1] = x + p
+				d[n] = x + p
+				e[n-1] = z
+				e[n] = -z
+			}
+			n -= 2
+			iter = 0
+		} else {
+			// No convergence yet - form the shift.
+			x = h[n][n]
+			y = 0
+			w = 0
+			if l < n {
+				y = h[n-1][n-1]
+				w = h[n-1][n] * h[n][n-1]
+			}
+
+			// Wilkinson's original ad hoc shift.
+			if iter == 10 {
+				exshift += x
+				for i := 0; i <= n; i++ {
+					h[i][i] -= x
+				}
+				s = abs(h[n-1][n]) + abs(h[n-2][n-1])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+
+			// MATLAB's ad hoc shift.
+			if iter == 30 {
+				s = (y - x) / 2
+				s = s*s + w
+				if s > 0 {
+					s = sqrt(s)
+					if y < x {
+						s = -s
+					}
+					s = x - w/((y-x)/2+s)
+					for i := 0; i <= n; i++ {
+						h[i][i] -= s
+					}
+					exshift += s
+					x = 0.964
+					y = x
+					w = x
+				}
+			}
+
+			iter++
+			if iter > maxEigenIterations {
+				return ErrNoConvergence
+			}
+
+			// Look for two consecutive small subdiagonal elements.
+			m := n - 2
+			for m >= l {
+				z = h[m][m]
+				r = x - z
+				s = y - z
+				p = (r*s-w)/h[m][m+1] + h[m+1][m]
+				q = h[m+1][m+1] - z - r - s
+				r = h[m+1][m+2]
+				s = abs(p) + abs(q) + abs(r)
+				p /= s
+				q /= s
+				r /= s
+				if m == l {
+					break
+				}
+				if abs(h[m-1][m])*(abs(q)+abs(r)) <
+					eps*(abs(p)*(abs(h[m-1][m-1])+abs(z)+abs(h[m+1][m+1]))) {
+					break
+				}
+				m--
+			}
+
+			for i := m + 2; i <= n; i++ {
+				h[i-2][i] = 0
+				if i > m+2 {
+					h[i-3][i] = 0
+				}
+			}
+
+			// Double QR step involving rows l:n and columns m:n.
+			for k := m; k <= n-1; k++ {
+				notLast := k != n-1
+				if k != m {
+					p = h[k-1][k]
+					q = h[k-1][k+1]
+					r = 0
+					if notLast {
+						r = h[k-1][k+2]
+					}
+					x = abs(p) + abs(q) + abs(r)
+					if x == 0 {
+						continue
+					}
+					p /= x
+					q /= x
+					r /= x
+				}
+
+				s = sqrt(p*p + q*q + r*r)
+				if p < 0 {
+					s = -s
+				}
+				if s == 0 {
+					continue
+				}
+
+				if k != m {
+					h[k-1][k] = -s * x
+				} else if l != m {
+					h[k-1][k] = -h[k-1][k]
+				}
+				p += s
+				x = p / s
+				y = q / s
+				z = r / s
+				q /= p
+				r /= p
+
+				for j := k; j < nn; j++ {
+					p = h[j][k] + q*h[j][k+1]
+					if notLast {
+						p += r * h[j][k+2]
+						h[j][k+2] -= p * z
+					}
+					h[j][k] -= p * x
+					h[j][k+1] -= p * y
+				}
+
+				for i := 0; i <= min(n, k+3); i++ {
+					p = x*h[k][i] + y*h[k+1][i]
+					if notLast {
+						p += z * h[k+2][i]
+						h[k+2][i] -= p * r
+					}
+					h[k][i] -= p
+					h[k+1][i] -= p * q
+				}
+
+				for i := 0; i < nn; i++ {
+					p = x*v[k][i] + y*v[k+1][i]
+					if notLast {
+						p += z * v[k+2][i]
+						v[k+2][i] -= p * r
+					}
+					v[k][i] -= p
+					v[k+1][i] -= p * q
+				}
+			}
+		}
+	}
+
+	// Back substitute to find the vectors of the upper triangular form.
+	if norm == 0 {
+		return nil
+	}
+
+	for n = nn - 1; n >= 0; n-- {
+		p = d[n]
+		q = e[n]
+
+		if q == 0 {
+			// Real vector.
+			l := n
+			h[n][n] = 1
+			for i := n - 1; i >= 0; i-- {
+				w = h[i][i] - p
+				r = 0
+				for j := l; j <= n; j++ {
+					r += h[j][i] * h[n][j]
+				}
+				if e[i] < 0 {
+					z = w
+					s = r
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					if w != 0 {
+						h[n][i] = -r / w
+					} else {
+						h[n][i] = -r / (eps * norm)
+					}
+				} else {
+					// Solve the real equations.
+					x = h[i+1][i]
+					y = h[i][i+1]
+					q = (d[i]-p)*(d[i]-p) + e[i]*e[i]
+					t = (x*s - z*r) / q
+					h[n][i] = t
+					if abs(x) > abs(z) {
+						h[n][i+1] = (-r - w*t) / x
+					} else {
+						h[n][i+1] = (-s - y*t) / z
+					}
+				}
+
+				// Overflow control.
+				t = abs(h[n][i])
+				if (eps*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h[n][j] /= t
+					}
+				}
+			}
+		} else if q < 0 {
+			// Complex vector.  The last component is taken to be
+			// imaginary, so the matrix is triangular.
+			l := n - 1
+			if abs(h[n-1][n]) > abs(h[n][n-1]) {
+				h[n-1][n-1] = q / h[n-1][n]
+				h[n][n-1] = -(h[n][n] - p) / h[n-1][n]
+			} else {
+				h[n-1][n-1], h[n][n-1] = cdiv(0, -h[n][n-1], h[n-1][n-1]-p, q)
+			}
+			h[n-1][n] = 0
+			h[n][n] = 1
+
+			for i := n - 2; i >= 0; i-- {
+				var ra, sa N
+				for j := l; j <= n; j++ {
+					ra += h[j][i] * h[n-1][j]
+					sa += h[j][i] * h[n][j]
+				}
+				w = h[i][i] - p
+
+				if e[i] < 0 {
+					z = w
+					r = ra
+					s = sa
+					continue
+				}
+
+				l = i
+				if e[i] == 0 {
+					h[n-1][i], h[n][i] = cdiv(-ra, -sa, w, q)
+				} else {
+					// Solve the complex equations.
+					x = h[i+1][i]
+					y = h[i][i+1]
+					vr := (d[i]-p)*(d[i]-p) + e[i]*e[i] - q*q
+					vi := (d[i] - p) * 2 * q
+					if vr == 0 && vi == 0 {
+						vr = eps * norm * (abs(w) + abs(q) + abs(x) + abs(y) + abs(z))
+					}
+					h[n-1][i], h[n][i] = cdiv(x*r-z*ra+q*sa, x*s-z*sa-q*ra, vr, vi)
+					if abs(x) > abs(z)+abs(q) {
+						h[n-1][i+1] = (-ra - w*h[n-1][i] + q*h[n][i]) / x
+						h[n][i+1] = (-sa - w*h[n][i] - q*h[n-1][i]) / x
+					} else {
+						h[n-1][i+1], h[n][i+1] = cdiv(-r-y*h[n-1][i], -s-y*h[n][i], z, q)
+					}
+				}
+
+				// Overflow control.
+				t = max(abs(h[n-1][i]), abs(h[n][i]))
+				if (eps*t)*t > 1 {
+					for j := i; j <= n; j++ {
+						h[n-1][j] /= t
+						h[n][j] /= t
+					}
+				}
+			}
+		}
+	}
+
+	// Back transformation to get the eigenvectors of the original matrix.
+	for j := nn - 1; j >= 0; j-- {
+		for i := 0; i < nn; i++ {
+			z = 0
+			for k := 0; k <= j; k++ {
+				z += v[k][i] * h[j][k]
+			}
+			v[j][i] = z
+		}
+	}
+
+	return nil
+}
+
+// normalizeVectors scales each eigenvector in v to unit length.  The real and
+// imaginary parts of a complex eigenvector are scaled together.
+func (es *eigenSolver[N]) normalizeVectors() {
+	for j := 0; j < es.n; j++ {
+		width := 1
+		if es.e[j] > 0 {
+			width = 2
+		}
+
+		var sumOfSquares N
+		for i := 0; i < es.n; i++ {
+			for k := j; k < j+width; k++ {
+				sumOfSquares += es.v[k][i] * es.v[k][i]
+			}
+		}
+
+		if sumOfSquares > 0 {
+			scale := 1 / sqrt(sumOfSquares)
+			for i := 0; i < es.n; i++ {
+				for k := j; k < j+width; k++ {
+					es.v[k][i] *= scale
+				}
+			}
+		}
+
+		j += width - 1
+	}
+}
+
+// cdiv returns the quotient (xr + i xi) / (yr + i yi), computed in N by Smith's
+// algorithm, which avoids overflow in the intermediate products.
+func cdiv[N constraints.Float](xr, xi, yr, yi N) (N, N) {
+	if abs(yr) > abs(yi) {
+		r := yi / yr
+		d := yr + r*yi
+		return (xr + r*xi) / d, (xi - r*xr) / d
+	}
+
+	r := yr / yi
+	d := yi + r*yr
+	return (r*xr + xi) / d, (r*xi - xr) / d
+}
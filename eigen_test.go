@@ -0,0 +1,234 @@
+package wyvern_test
+
+import (
+	"math"
+	"math/cmplx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// expectEigenpairs asserts that Av = lv for every eigenvalue l of the
+// decomposition, using the packed real/imaginary column layout of Vectors.
+func expectEigenpairs(rows []wyvern.Vector[float64], eig *wyvern.Eigen[float64]) {
+	values := eig.Values()
+	vectors := eig.Vectors().Columns()
+
+	for j := 0; j < len(values); j++ {
+		v := make([]complex128, len(rows))
+		for i := range v {
+			v[i] = complex(vectors[j][i], 0)
+			if imag(values[j]) > 0 {
+				v[i] += complex(0, vectors[j+1][i])
+			} else if imag(values[j]) < 0 {
+				v[i] = complex(vectors[j-1][i], -vectors[j][i])
+			}
+		}
+
+		var norm float64
+		for i, row := range rows {
+			var av complex128
+			for k, val := range row {
+				av += complex(val, 0) * v[k]
+			}
+			ExpectWithOffset(1, cmplx.Abs(av-values[j]*v[i])).To(BeNumerically("<", 1e-9), "eigenvalue %d", j)
+			norm += cmplx.Abs(v[i]) * cmplx.Abs(v[i])
+		}
+		ExpectWithOffset(1, math.Sqrt(norm)).To(BeNumerically("~", 1, 1e-12), "eigenvector %d", j)
+	}
+}
+
+var _ = Describe("Eigen", func() {
+	var (
+		mt   wyvern.Matrix[float64]
+		rows []wyvern.Vector[float64]
+		eig  *wyvern.Eigen[float64]
+		err  error
+	)
+
+	JustBeforeEach(func() {
+		mt, _ = wyvern.FromRows(rows)
+		eig, err = mt.Eigen()
+	})
+
+	When("The matrix is symmetric", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{2, -1, 0},
+				{-1, 2, -1},
+				{0, -1, 2},
+			}
+		})
+
+		It("Returns the real eigenvalues in ascending order", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eig.IsSymmetric()).To(BeTrue())
+			expectVectorNear(eig.RealValues(), wyvern.Vector[float64]{2 - math.Sqrt2, 2, 2 + math.Sqrt2}, 1e-12)
+			for _, val := range eig.Values() {
+				Expect(imag(val)).To(BeZero())
+			}
+		})
+
+		It("Returns orthonormal eigenvectors", func() {
+			expectOrthonormalColumns(eig.Vectors())
+			expectEigenpairs(rows, eig)
+		})
+	})
+
+	When("The matrix is symmetric only to within rounding", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{2, 1},
+				{math.Nextafter(1, 2), 2},
+			}
+		})
+
+		It("Is treated as symmetric", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eig.IsSymmetric()).To(BeTrue())
+			expectVectorNear(eig.RealValues(), wyvern.Vector[float64]{1, 3}, 1e-12)
+			expectOrthonormalColumns(eig.Vectors())
+		})
+	})
+
+	When("The matrix is float32", func() {
+		It("Decomposes it in single precision", func() {
+			m, _ := wyvern.FromRows([]wyvern.Vector[float32]{
+				{2, -1, 0},
+				{-1, 2, -1},
+				{0, -1, 2},
+			})
+			e, err := m.Eigen()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(e.IsSymmetric()).To(BeTrue())
+
+			values := e.RealValues()
+			Expect(values).To(HaveLen(3))
+			for i, expected := range []float64{2 - math.Sqrt2, 2, 2 + math.Sqrt2} {
+				Expect(float64(values[i])).To(BeNumerically("~", expected, 1e-5))
+			}
+		})
+	})
+
+	When("The matrix is not symmetric", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{4, 1},
+				{2, 3},
+			}
+		})
+
+		It("Returns the eigenvalues and eigenvectors", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eig.IsSymmetric()).To(BeFalse())
+			Expect(eig.RealValues()).To(ConsistOf(
+				BeNumerically("~", 2, 1e-12),
+				BeNumerically("~", 5, 1e-12),
+			))
+			expectEigenpairs(rows, eig)
+		})
+	})
+
+	When("The matrix has complex eigenvalues", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{0, -1, 0},
+				{1, 0, 0},
+				{0, 0, 2},
+			}
+		})
+
+		It("Reports them as a conjugate pair", func() {
+			Expect(err).NotTo(HaveOccurred())
+			values := eig.Values()
+			Expect(values).To(HaveLen(3))
+
+			var pair []complex128
+			for _, val := range values {
+				if imag(val) != 0 {
+					pair = append(pair, val)
+				}
+			}
+			Expect(pair).To(HaveLen(2))
+			Expect(cmplx.Abs(pair[0] - cmplx.Conj(pair[1]))).To(BeNumerically("<", 1e-12))
+			Expect(math.Abs(imag(pair[0]))).To(BeNumerically("~", 1, 1e-12))
+
+			expectEigenpairs(rows, eig)
+		})
+	})
+
+	When("The matrix is larger and has no special structure", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 7, -3, 2, 0.5},
+				{4, -2, 6, 1, 3},
+				{-5, 2, 8, 0, -1},
+				{3, 3, 1, -4, 2},
+				{0, 6, -2, 5, 1},
+			}
+		})
+
+		It("Returns valid eigenpairs", func() {
+			Expect(err).NotTo(HaveOccurred())
+			expectEigenpairs(rows, eig)
+
+			var sum complex128
+			for _, val := range eig.Values() {
+				sum += val
+			}
+			Expect(real(sum)).To(BeNumerically("~", 4, 1e-10))
+			Expect(imag(sum)).To(BeNumerically("~", 0, 1e-10))
+		})
+	})
+
+	When("The matrix is not square", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 2, 3},
+				{4, 5, 6},
+			}
+		})
+
+		It("Returns ErrNotSquare", func() {
+			Expect(eig).To(BeNil())
+			Expect(err).To(MatchError(wyvern.ErrNotSquare))
+		})
+	})
+
+	Describe("PowerIteration", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{2, 1},
+				{1, 3},
+			}
+		})
+
+		It("Returns the dominant eigenpair", func() {
+			lambda, v, e := mt.PowerIteration(nil, 1000, 1e-10)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(lambda).To(BeNumerically("~", (5+math.Sqrt(5))/2, 1e-9))
+			Expect(v.Magnitude()).To(BeNumerically("~", 1, 1e-12))
+
+			av, _ := mt.Product(must(wyvern.FromColumns([]wyvern.Vector[float64]{v})))
+			expectVectorNear(av.Columns()[0], append(wyvern.Vector[float64]{}, v...).Multiply(lambda), 1e-8)
+		})
+
+		When("The iteration limit is too low", func() {
+			It("Returns the latest estimate and ErrNoConvergence", func() {
+				lambda, v, e := mt.PowerIteration(wyvern.Vector[float64]{1, 0}, 2, 1e-12)
+				Expect(e).To(MatchError(wyvern.ErrNoConvergence))
+				Expect(v).To(HaveLen(2))
+				Expect(lambda).To(BeNumerically(">", 2))
+			})
+		})
+
+		When("The starting vector is zero", func() {
+			It("Returns ErrZeroVector", func() {
+				_, _, e := mt.PowerIteration(wyvern.Vector[float64]{0, 0}, 10, 1e-10)
+				Expect(e).To(MatchError(wyvern.ErrZeroVector))
+			})
+		})
+	})
+})
@@ -0,0 +1,132 @@
+package wyvern
+
+// Add returns the sum a + b as a new Matrix.  A *DimensionError is returned if
+// the matrices do not have the same shape.
+func (a Matrix[N]) Add(b Matrix[N]) (Matrix[N], error) {
+	return a.combine(b, "Add", func(x, y N) N { return x + y })
+}
+
+// AddInPlace adds b to a, modifying a.  A *DimensionError is returned, and a left
+// unchanged, if the matrices do not have the same shape.
+func (a Matrix[N]) AddInPlace(b Matrix[N]) error {
+	return a.combineInPlace(b, "AddInPlace", func(x, y N) N { return x + y })
+}
+
+// Subtract returns the difference a - b as a new Matrix.  A *DimensionError is
+// returned if the matrices do not have the same shape.
+func (a Matrix[N]) Subtract(b Matrix[N]) (Matrix[N], error) {
+	return a.combine(b, "Subtract", func(x, y N) N { return x - y })
+}
+
+// SubtractInPlace subtracts b from a, modifying a.  A *DimensionError is
+// returned, and a left unchanged, if the matrices do not have the same shape.
+func (a Matrix[N]) SubtractInPlace(b Matrix[N]) error {
+	return a.combineInPlace(b, "SubtractInPlace", func(x, y N) N { return x - y })
+}
+
+// Hadamard returns the element-wise product of a and b as a new Matrix.  A
+// *DimensionError is returned if the matrices do not have the same shape.
+func (a Matrix[N]) Hadamard(b Matrix[N]) (Matrix[N], error) {
+	return a.combine(b, "Hadamard", func(x, y N) N { return x * y })
+}
+
+// HadamardInPlace multiplies each entry of a by the corresponding entry of b,
+// modifying a.  A *DimensionError is returned, and a left unchanged, if the
+// matrices do not have the same shape.
+func (a Matrix[N]) HadamardInPlace(b Matrix[N]) error {
+	return a.combineInPlace(b, "HadamardInPlace", func(x, y N) N { return x * y })
+}
+
+// Scale returns a new Matrix with every entry of a multiplied by factor.
+func (a Matrix[N]) Scale(factor N) Matrix[N] {
+	return a.Apply(func(x N) N { return x * factor })
+}
+
+// ScaleInPlace multiplies every entry of a by factor, modifying a.
+// ErrImmutable is returned if a is frozen.
+func (a Matrix[N]) ScaleInPlace(factor N) error {
+	if a.frozen {
+		return ErrImmutable
+	}
+
+	for _, c := range a.columnViews() {
+		c.Multiply(factor)
+	}
+
+	return nil
+}
+
+// Apply returns a new Matrix whose entries are the result of calling fn on the
+// corresponding entries of a.
+func (a Matrix[N]) Apply(fn func(N) N) Matrix[N] {
+	result := a.Clone()
+	result.apply(fn)
+	return result
+}
+
+// ApplyInPlace replaces every entry of a with the result of calling fn on it.
+// ErrImmutable is returned if a is frozen.
+func (a Matrix[N]) ApplyInPlace(fn func(N) N) error {
+	if a.frozen {
+		return ErrImmutable
+	}
+
+	a.apply(fn)
+	return nil
+}
+
+// apply replaces every entry of a with the result of calling fn on it.
+func (a Matrix[N]) apply(fn func(N) N) {
+	for _, c := range a.columnViews() {
+		for ri, val := range c {
+			c[ri] = fn(val)
+		}
+	}
+}
+
+// sameShape returns a *DimensionError if a and b differ in shape, and nil
+// otherwise.
+func (a Matrix[N]) sameShape(b Matrix[N], op string) error {
+	aRows, aCols := a.Dims()
+	bRows, bCols := b.Dims()
+	if aRows != bRows || aCols != bCols {
+		return &DimensionError{
+			Op:       op,
+			Expected: Shape{Rows: aRows, Columns: aCols},
+			Actual:   Shape{Rows: bRows, Columns: bCols},
+		}
+	}
+
+	return nil
+}
+
+// combine returns a new Matrix whose entries are fn applied to the corresponding
+// entries of a and b.
+func (a Matrix[N]) combine(b Matrix[N], op string, fn func(x, y N) N) (Matrix[N], error) {
+	result := a.Clone()
+	if err := result.combineInPlace(b, op, fn); err != nil {
+		return Matrix[N]{}, err
+	}
+
+	return result, nil
+}
+
+// combineInPlace replaces each entry of a with fn applied to it and the
+// corresponding entry of b.  ErrImmutable is returned if a is frozen.
+func (a Matrix[N]) combineInPlace(b Matrix[N], op string, fn func(x, y N) N) error {
+	if a.frozen {
+		return ErrImmutable
+	}
+
+	if err := a.sameShape(b, op); err != nil {
+		return err
+	}
+
+	for ci, c := range a.columnViews() {
+		for ri, val := range b.col(ci) {
+			c[ri] = fn(c[ri], val)
+		}
+	}
+
+	return nil
+}
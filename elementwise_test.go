@@ -0,0 +1,167 @@
+package wyvern_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+var _ = Describe("Element-wise arithmetic", func() {
+	var (
+		mtA, mtB     wyvern.Matrix[float64]
+		rowsA, rowsB []wyvern.Vector[float64]
+	)
+
+	BeforeEach(func() {
+		rowsA = []wyvern.Vector[float64]{
+			{1, 2, 3},
+			{4, 5, 6},
+		}
+		rowsB = []wyvern.Vector[float64]{
+			{6, -1, 0.5},
+			{2, 2, -3},
+		}
+	})
+
+	JustBeforeEach(func() {
+		mtA, _ = wyvern.FromRows(rowsA)
+		mtB, _ = wyvern.FromRows(rowsB)
+	})
+
+	Describe("Add", func() {
+		It("Returns the sum and leaves the operands unchanged", func() {
+			sum, e := mtA.Add(mtB)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(sum.Rows()).To(Equal([]wyvern.Vector[float64]{
+				{7, 1, 3.5},
+				{6, 7, 3},
+			}))
+			Expect(mtA.Rows()).To(Equal(rowsA))
+			Expect(mtB.Rows()).To(Equal(rowsB))
+		})
+
+		When("The shapes differ", func() {
+			BeforeEach(func() {
+				rowsB = []wyvern.Vector[float64]{
+					{6, -1},
+					{2, 2},
+				}
+			})
+
+			It("Returns a DimensionError", func() {
+				_, e := mtA.Add(mtB)
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("AddInPlace", func() {
+		It("Adds to the receiver", func() {
+			Expect(mtA.AddInPlace(mtB)).To(Succeed())
+			Expect(mtA.Rows()).To(Equal([]wyvern.Vector[float64]{
+				{7, 1, 3.5},
+				{6, 7, 3},
+			}))
+			Expect(mtB.Rows()).To(Equal(rowsB))
+		})
+
+		When("The shapes differ", func() {
+			BeforeEach(func() {
+				rowsB = []wyvern.Vector[float64]{
+					{6, -1, 0},
+				}
+			})
+
+			It("Returns a DimensionError and leaves the receiver unchanged", func() {
+				Expect(mtA.AddInPlace(mtB)).To(MatchError(wyvern.ErrDimensionMismatch))
+				Expect(mtA.Rows()).To(Equal(rowsA))
+			})
+		})
+	})
+
+	Describe("Subtract", func() {
+		It("Returns the difference", func() {
+			diff, e := mtA.Subtract(mtB)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(diff.Rows()).To(Equal([]wyvern.Vector[float64]{
+				{-5, 3, 2.5},
+				{2, 3, 9},
+			}))
+			Expect(mtA.Rows()).To(Equal(rowsA))
+		})
+	})
+
+	Describe("SubtractInPlace", func() {
+		It("Subtracts from the receiver", func() {
+			Expect(mtA.SubtractInPlace(mtB)).To(Succeed())
+			Expect(mtA.Rows()).To(Equal([]wyvern.Vector[float64]{
+				{-5, 3, 2.5},
+				{2, 3, 9},
+			}))
+		})
+	})
+
+	Describe("Hadamard", func() {
+		It("Returns the element-wise product", func() {
+			h, e := mtA.Hadamard(mtB)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(h.Rows()).To(Equal([]wyvern.Vector[float64]{
+				{6, -2, 1.5},
+				{8, 10, -18},
+			}))
+			Expect(mtA.Rows()).To(Equal(rowsA))
+		})
+	})
+
+	Describe("HadamardInPlace", func() {
+		It("Multiplies the receiver element-wise", func() {
+			Expect(mtA.HadamardInPlace(mtB)).To(Succeed())
+			Expect(mtA.Rows()).To(Equal([]wyvern.Vector[float64]{
+				{6, -2, 1.5},
+				{8, 10, -18},
+			}))
+		})
+	})
+
+	Describe("Scale", func() {
+		It("Returns a scaled copy", func() {
+			Expect(mtA.Scale(2).Rows()).To(Equal([]wyvern.Vector[float64]{
+				{2, 4, 6},
+				{8, 10, 12},
+			}))
+			Expect(mtA.Rows()).To(Equal(rowsA))
+		})
+	})
+
+	Describe("ScaleInPlace", func() {
+		It("Scales the receiver", func() {
+			Expect(mtA.ScaleInPlace(-1)).To(Succeed())
+			Expect(mtA.Rows()).To(Equal([]wyvern.Vector[float64]{
+				{-1, -2, -3},
+				{-4, -5, -6},
+			}))
+		})
+	})
+
+	Describe("Apply", func() {
+		It("Returns a copy with the function applied to every entry", func() {
+			sq := mtA.Apply(func(x float64) float64 { return x * x })
+			Expect(sq.Rows()).To(Equal([]wyvern.Vector[float64]{
+				{1, 4, 9},
+				{16, 25, 36},
+			}))
+			Expect(mtA.Rows()).To(Equal(rowsA))
+		})
+	})
+
+	Describe("ApplyInPlace", func() {
+		It("Applies the function to every entry of the receiver", func() {
+			Expect(mtA.ApplyInPlace(math.Sqrt)).To(Succeed())
+			Expect(mtA.Rows()[0][1]).To(Equal(math.Sqrt(2)))
+			Expect(mtA.Rows()[1][1]).To(Equal(math.Sqrt(5)))
+		})
+	})
+})
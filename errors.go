@@ -0,0 +1,125 @@
+package wyvern
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Every error returned by wyvern is, or wraps, one of the sentinel errors below,
+// so callers can classify failures with errors.Is.  Where more detail is
+// available it is carried by a DimensionError, IndexError or
+// UnderdeterminedError, which can be extracted with errors.As.
+
+// ErrIndexOutOfRange is returned (wrapped in an IndexError) when a row, column
+// or component index lies outside the Matrix or Vector.
+var ErrIndexOutOfRange = errors.New("Index out of range")
+
+// ErrDimensionMismatch is returned (usually wrapped in a DimensionError) when the
+// operands of an operation have incompatible dimensions.
+var ErrDimensionMismatch = errors.New("Dimension mismatch")
+
+// ErrZeroVector is returned when an operation requiring a nonzero Vector is
+// applied to a Vector whose components are all zero.
+var ErrZeroVector = errors.New("Vector has zero magnitude")
+
+// ErrNotSquare is returned when an operation requiring a square Matrix is
+// applied to a Matrix whose row and column counts differ.
+var ErrNotSquare = errors.New("Matrix is not square")
+
+// ErrSingular is returned when an operation requiring an invertible Matrix is
+// applied to a singular one.
+var ErrSingular = errors.New("Matrix is singular")
+
+// ErrNotSymmetric is returned when an operation requiring a symmetric Matrix is
+// applied to a nonsymmetric one.
+var ErrNotSymmetric = errors.New("Matrix is not symmetric")
+
+// ErrNotPositiveDefinite is returned when an operation requiring a positive
+// definite Matrix is applied to one which is not.
+var ErrNotPositiveDefinite = errors.New("Matrix is not positive definite")
+
+// ErrRankDeficient is returned when an operation requiring a Matrix with
+// linearly independent columns is applied to one whose columns are dependent.
+var ErrRankDeficient = errors.New("Matrix does not have full column rank")
+
+// ErrNoConvergence is returned when an iterative algorithm fails to converge
+// within its iteration limit.
+var ErrNoConvergence = errors.New("Iteration did not converge")
+
+// ErrMalformedSparse is returned when compressed sparse storage supplied by the
+// caller is not internally consistent.
+var ErrMalformedSparse = errors.New("Malformed sparse matrix storage")
+
+// ErrImmutable is returned when an operation which would modify a Matrix is
+// applied to one made immutable by Freeze.
+var ErrImmutable = errors.New("Matrix is immutable")
+
+// ErrInconsistent is returned when a linear system has no solution.
+var ErrInconsistent = errors.New("System is inconsistent")
+
+// ErrUnderdetermined is returned (wrapped in an UnderdeterminedError) when a
+// linear system has infinitely many solutions.
+var ErrUnderdetermined = errors.New("System is underdetermined")
+
+// Shape describes the dimensions of a Matrix.  A Vector is treated as a single
+// column, so a Vector of dimension n has the Shape n x 1.
+type Shape struct {
+	Rows    int
+	Columns int
+}
+
+func (s Shape) String() string {
+	return fmt.Sprintf("%dx%d", s.Rows, s.Columns)
+}
+
+// DimensionError reports an operation whose operands have incompatible dimensions.
+// Expected is the shape the operation required, Actual the shape it received.
+// A DimensionError matches ErrDimensionMismatch when used with errors.Is.
+type DimensionError struct {
+	Op       string
+	Expected Shape
+	Actual   Shape
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("%s: %s: expected %s, got %s", e.Op, ErrDimensionMismatch, e.Expected, e.Actual)
+}
+
+func (e *DimensionError) Unwrap() error {
+	return ErrDimensionMismatch
+}
+
+// IndexError reports an index which lies outside the valid range [0, Limit).
+// An IndexError matches ErrIndexOutOfRange when used with errors.Is.
+type IndexError struct {
+	Op    string
+	Index int
+	Limit int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("%s: %s: %d not in [0, %d)", e.Op, ErrIndexOutOfRange, e.Index, e.Limit)
+}
+
+func (e *IndexError) Unwrap() error {
+	return ErrIndexOutOfRange
+}
+
+// UnderdeterminedError reports a linear system with infinitely many solutions.
+// NullSpace holds a basis for the null space of the coefficient matrix: every
+// solution is the particular solution returned alongside the error plus some
+// linear combination of these vectors.  An UnderdeterminedError matches
+// ErrUnderdetermined when used with errors.Is.
+type UnderdeterminedError[N constraints.Float] struct {
+	NullSpace []Vector[N]
+}
+
+func (e *UnderdeterminedError[N]) Error() string {
+	return fmt.Sprintf("%s: null space has dimension %d", ErrUnderdetermined, len(e.NullSpace))
+}
+
+func (e *UnderdeterminedError[N]) Unwrap() error {
+	return ErrUnderdetermined
+}
@@ -0,0 +1,31 @@
+// Package numeric holds the floating point helpers shared by wyvern and its
+// subpackages.
+package numeric
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Epsilon returns the machine epsilon for N - the difference between 1 and the
+// next representable value.
+func Epsilon[N constraints.Float]() N {
+	var (
+		one N = 1
+		eps N = 1
+	)
+
+	for one+eps/2 != one {
+		eps /= 2
+	}
+
+	return eps
+}
+
+// Abs returns the absolute value of x.
+func Abs[N constraints.Float](x N) N {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,29 @@
+// Package testutil holds the Gomega assertions shared by the wyvern test suites.
+package testutil
+
+import (
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// ExpectVectorNear asserts that actual and expected have the same dimension and
+// that corresponding components differ by no more than tolerance.
+func ExpectVectorNear(actual, expected wyvern.Vector[float64], tolerance float64) {
+	ExpectWithOffset(1, actual).To(HaveLen(len(expected)))
+	for i := range expected {
+		ExpectWithOffset(1, actual[i]).To(BeNumerically("~", expected[i], tolerance), "component %d", i)
+	}
+}
+
+// ExpectVectorsNear asserts that each vector in actual is near the corresponding
+// vector in expected.
+func ExpectVectorsNear(actual, expected []wyvern.Vector[float64], tolerance float64) {
+	ExpectWithOffset(1, actual).To(HaveLen(len(expected)))
+	for i := range expected {
+		ExpectWithOffset(1, actual[i]).To(HaveLen(len(expected[i])), "vector %d", i)
+		for j := range expected[i] {
+			ExpectWithOffset(1, actual[i][j]).To(BeNumerically("~", expected[i][j], tolerance), "vector %d, component %d", i, j)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package wyvern
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// LU is the LU decomposition, with partial pivoting, of a square Matrix A:
+// PA = LU, where P is a permutation matrix, L is unit lower triangular and U is
+// upper triangular.  Once computed, an LU can be used to solve any number of
+// systems against A, and to compute its determinant and inverse, without
+// repeating the elimination.
+type LU[N constraints.Float] struct {
+	// lu holds the multipliers of L below the diagonal (the unit diagonal
+	// of L is implicit) and U on and above it.
+	lu       Matrix[N]
+	pivot    []int
+	sign     N
+	singular bool
+}
+
+// LU computes the LU decomposition of the Matrix.  ErrNotSquare is returned if
+// the Matrix is not square.  A singular Matrix can still be factored; the
+// resulting LU reports ErrSingular from Solve and Inverse.
+func (a Matrix[N]) LU() (*LU[N], error) {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return nil, ErrNotSquare
+	}
+
+	f := &LU[N]{
+		lu:    a.Clone(),
+		pivot: make([]int, rows),
+		sign:  1,
+	}
+	for i := range f.pivot {
+		f.pivot[i] = i
+	}
+
+	// A pivot is judged against the scale of its own column, so that a
+	// badly scaled but nonsingular Matrix is not reported as singular.
+	cs := f.lu.columnViews()
+	tols := make([]N, cols)
+	for k, c := range cs {
+		tols[k] = N(rows) * c.maxAbs() * epsilon[N]()
+	}
+
+	for k := 0; k < cols; k++ {
+		ck := cs[k]
+
+		p := k
+		for ri := k + 1; ri < rows; ri++ {
+			if abs(ck[ri]) > abs(ck[p]) {
+				p = ri
+			}
+		}
+
+		if p != k {
+			f.lu.swapRows(p, k)
+			f.pivot[p], f.pivot[k] = f.pivot[k], f.pivot[p]
+			f.sign = -f.sign
+		}
+
+		if abs(ck[k]) <= tols[k] {
+			f.singular = true
+		}
+
+		if ck[k] == 0 {
+			// Nothing to eliminate with - the column below the
+			// diagonal is already zero.
+			continue
+		}
+
+		for ri := k + 1; ri < rows; ri++ {
+			ck[ri] /= ck[k]
+		}
+
+		// Update the trailing submatrix one column at a time, which
+		// keeps every inner loop running down a single stored column.
+		for j := k + 1; j < cols; j++ {
+			cj := cs[j]
+			if cj[k] == 0 {
+				continue
+			}
+			for ri := k + 1; ri < rows; ri++ {
+				cj[ri] -= ck[ri] * cj[k]
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// L returns the unit lower triangular factor.
+func (f *LU[N]) L() Matrix[N] {
+	n := len(f.pivot)
+	cols := make([]Vector[N], n)
+	for ci := range cols {
+		cols[ci] = make(Vector[N], n)
+		cols[ci][ci] = 1
+		copy(cols[ci][ci+1:], f.lu.col(ci)[ci+1:])
+	}
+
+	return fromColumns(cols)
+}
+
+// U returns the upper triangular factor.
+func (f *LU[N]) U() Matrix[N] {
+	n := len(f.pivot)
+	cols := make([]Vector[N], n)
+	for ci := range cols {
+		cols[ci] = make(Vector[N], n)
+		copy(cols[ci][:ci+1], f.lu.col(ci)[:ci+1])
+	}
+
+	return fromColumns(cols)
+}
+
+// Permutation returns the row permutation applied during factorization: row i
+// of PA is row Permutation()[i] of A.
+func (f *LU[N]) Permutation() []int {
+	return append([]int{}, f.pivot...)
+}
+
+// P returns the permutation matrix P for which PA = LU.
+func (f *LU[N]) P() Matrix[N] {
+	n := len(f.pivot)
+	p := Zeros[N](n, n)
+	for ri, src := range f.pivot {
+		p.col(src)[ri] = 1
+	}
+
+	return p
+}
+
+// IsSingular returns true if the factored Matrix is singular.
+func (f *LU[N]) IsSingular() bool {
+	return f.singular
+}
+
+// Determinant returns the determinant of the factored Matrix: the product of
+// the diagonal of U, negated if P is an odd permutation.  No tolerance is
+// applied, so a Matrix reported as singular by IsSingular may have a tiny
+// nonzero determinant.
+func (f *LU[N]) Determinant() N {
+	det := f.sign
+	for i, c := range f.lu.columnViews() {
+		det *= c[i]
+	}
+
+	return det
+}
+
+// Solve solves Ax = b for x, where A is the factored Matrix.  ErrSingular is
+// returned if A is singular, and a *DimensionError if b has the wrong dimension.
+func (f *LU[N]) Solve(b Vector[N]) (Vector[N], error) {
+	n := len(f.pivot)
+	if len(b) != n {
+		return nil, &DimensionError{
+			Op:       "LU.Solve",
+			Expected: Shape{Rows: n, Columns: 1},
+			Actual:   Shape{Rows: len(b), Columns: 1},
+		}
+	}
+
+	if f.singular {
+		return nil, ErrSingular
+	}
+
+	x := make(Vector[N], n)
+	for ri, src := range f.pivot {
+		x[ri] = b[src]
+	}
+	f.solveInPlace(x)
+
+	return x, nil
+}
+
+// Inverse returns the inverse of the factored Matrix, or ErrSingular if it has
+// none.
+func (f *LU[N]) Inverse() (Matrix[N], error) {
+	if f.singular {
+		return Matrix[N]{}, ErrSingular
+	}
+
+	n := len(f.pivot)
+	cols := make([]Vector[N], n)
+	for ci := range cols {
+		x := make(Vector[N], n)
+		for ri, src := range f.pivot {
+			if src == ci {
+				x[ri] = 1
+			}
+		}
+		f.solveInPlace(x)
+		cols[ci] = x
+	}
+
+	return fromColumns(cols), nil
+}
+
+// solveInPlace overwrites x, which must already be permuted, with the solution
+// of LUx = x.
+func (f *LU[N]) solveInPlace(x Vector[N]) {
+	cs := f.lu.columnViews()
+
+	// Forward substitution with the unit lower triangle, column by column.
+	for k, c := range cs {
+		for ri := k + 1; ri < len(x); ri++ {
+			x[ri] -= c[ri] * x[k]
+		}
+	}
+
+	// Back substitution with the upper triangle.
+	for k := len(cs) - 1; k >= 0; k-- {
+		c := cs[k]
+		x[k] /= c[k]
+		for ri := 0; ri < k; ri++ {
+			x[ri] -= c[ri] * x[k]
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package wyvern_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+var _ = Describe("LU", func() {
+	var (
+		mt   wyvern.Matrix[float64]
+		rows []wyvern.Vector[float64]
+		lu   *wyvern.LU[float64]
+		err  error
+	)
+
+	BeforeEach(func() {
+		rows = []wyvern.Vector[float64]{
+			{2, 1, 1},
+			{4, -6, 0},
+			{-2, 7, 2},
+		}
+	})
+
+	JustBeforeEach(func() {
+		mt, _ = wyvern.FromRows(rows)
+		lu, err = mt.LU()
+	})
+
+	It("Factors the matrix so that PA = LU", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lu.IsSingular()).To(BeFalse())
+
+		pa, _ := lu.P().Product(mt)
+		product, _ := lu.L().Product(lu.U())
+		expectVectorsNear(product.Rows(), pa.Rows(), 1e-12)
+	})
+
+	It("Returns triangular factors", func() {
+		l := lu.L().Rows()
+		u := lu.U().Rows()
+		for i := range l {
+			Expect(l[i][i]).To(Equal(1.0))
+			for j := i + 1; j < len(l); j++ {
+				Expect(l[i][j]).To(BeZero())
+				Expect(u[j][i]).To(BeZero())
+			}
+		}
+	})
+
+	It("Uses partial pivoting", func() {
+		Expect(lu.Permutation()[0]).To(Equal(1))
+	})
+
+	It("Leaves the original matrix unchanged", func() {
+		Expect(mt.Rows()).To(Equal(rows))
+	})
+
+	Describe("Solve", func() {
+		It("Solves the system", func() {
+			x, e := lu.Solve(wyvern.Vector[float64]{5, -2, 9})
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(x, wyvern.Vector[float64]{1, 1, 2}, 1e-12)
+		})
+
+		It("Can be reused for further right-hand sides", func() {
+			lu.Solve(wyvern.Vector[float64]{5, -2, 9})
+			x, e := lu.Solve(wyvern.Vector[float64]{4, 4, 2})
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(x, wyvern.Vector[float64]{1, 0, 2}, 1e-12)
+		})
+
+		When("The right-hand side has the wrong dimension", func() {
+			It("Returns a DimensionError", func() {
+				_, e := lu.Solve(wyvern.Vector[float64]{5, -2})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Determinant", func() {
+		It("Returns the determinant", func() {
+			Expect(lu.Determinant()).To(BeNumerically("~", -16, 1e-12))
+		})
+	})
+
+	Describe("Inverse", func() {
+		It("Returns the inverse", func() {
+			inv, e := lu.Inverse()
+			Expect(e).NotTo(HaveOccurred())
+
+			product, _ := mt.Product(inv)
+			expectVectorsNear(product.Rows(), []wyvern.Vector[float64]{
+				{1, 0, 0},
+				{0, 1, 0},
+				{0, 0, 1},
+			}, 1e-12)
+		})
+	})
+
+	When("The matrix is singular", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 2, -1},
+				{2, 1, 1},
+				{1, -1, 2},
+			}
+		})
+
+		It("Still factors the matrix", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lu.IsSingular()).To(BeTrue())
+		})
+
+		It("Returns ErrSingular from Solve and Inverse", func() {
+			_, e := lu.Solve(wyvern.Vector[float64]{1, 1, 1})
+			Expect(e).To(MatchError(wyvern.ErrSingular))
+
+			_, e = lu.Inverse()
+			Expect(e).To(MatchError(wyvern.ErrSingular))
+		})
+
+		It("Has a zero determinant", func() {
+			Expect(lu.Determinant()).To(BeZero())
+		})
+	})
+
+	When("The matrix is badly scaled but nonsingular", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1e-8, 0},
+				{0, 1e8},
+			}
+		})
+
+		It("Is not reported as singular", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lu.IsSingular()).To(BeFalse())
+			Expect(lu.Determinant()).To(Equal(1.0))
+		})
+
+		It("Solves against the matrix", func() {
+			x, e := lu.Solve(wyvern.Vector[float64]{1, 1})
+			Expect(e).NotTo(HaveOccurred())
+			Expect(x).To(Equal(wyvern.Vector[float64]{1e8, 1e-8}))
+		})
+	})
+
+	When("The matrix is not square", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 2, 3},
+				{4, 5, 6},
+			}
+		})
+
+		It("Returns ErrNotSquare", func() {
+			Expect(lu).To(BeNil())
+			Expect(err).To(MatchError(wyvern.ErrNotSquare))
+		})
+	})
+})
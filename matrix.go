@@ -1,26 +1,96 @@
 package wyvern
 
 import (
-	"errors"
-
 	"golang.org/x/exp/constraints"
 )
 
-// A Matrix comprises one or more vectors.  These are passed in as column vectors.
+// A Matrix comprises zero or more vectors.  These are passed in as column vectors.
+//
+// Either dimension may be zero.  Such a Matrix is valid everywhere an ordinary
+// one is: a 0 x n Matrix has n columns with no components, an n x 0 Matrix has n
+// rows with no components, and the zero value is the 0 x 0 Matrix.  Operations on
+// them follow the usual shape rules, so for example the product of an m x 0 and
+// a 0 x n Matrix is the m x n zero Matrix.
+//
+// The entries are stored in a single slice, in column-major order: entry (i, j)
+// is data[j*stride+i].  Each column is therefore contiguous, and can be handed
+// out as a Vector sharing the Matrix's storage (see ColumnView) without copying.
+// The stride is at least the number of rows; it is greater for a view of part
+// of a larger Matrix (see Slice), whose columns are not adjacent in storage.
+//
+// Constructors and methods which take vectors copy them in, and methods which
+// return vectors return copies, with the exception of ColumnView; a Matrix never
+// shares storage with the caller's vectors unless built by FromColumnMajor.
 type Matrix[N constraints.Float] struct {
-	columns []Vector[N]
+	data       []N
+	rows, cols int
+	stride     int
+
+	// frozen is set on a Matrix returned by Freeze, and on any view of one;
+	// every method which would modify such a Matrix returns ErrImmutable.
+	frozen bool
 }
 
-func sameDimensionCount[N constraints.Float](c []Vector[N]) bool {
-	for i, _ := range c {
-		if i > 0 {
-			if !c[i].sameDimension(c[i-1]) {
-				return false
-			}
+// newMatrix returns a rows x cols Matrix with every entry zero.
+func newMatrix[N constraints.Float](rows, cols int) Matrix[N] {
+	return Matrix[N]{data: make([]N, rows*cols), rows: rows, cols: cols, stride: rows}
+}
+
+// fromColumns returns a Matrix holding a copy of the given columns, which must
+// all have the same dimension.
+func fromColumns[N constraints.Float](c []Vector[N]) Matrix[N] {
+	rows := 0
+	if len(c) > 0 {
+		rows = len(c[0])
+	}
+
+	m := newMatrix[N](rows, len(c))
+	for ci, col := range c {
+		copy(m.col(ci), col)
+	}
+
+	return m
+}
+
+// col returns column j as a Vector sharing the Matrix's storage.  Its capacity is
+// limited to its length, so appending to it cannot overwrite the next column.
+func (a Matrix[N]) col(j int) Vector[N] {
+	start := j * a.stride
+	return Vector[N](a.data[start : start+a.rows : start+a.rows])
+}
+
+// columnViews returns every column of the Matrix as a Vector sharing the
+// Matrix's storage, as col does.
+func (a Matrix[N]) columnViews() []Vector[N] {
+	views := make([]Vector[N], a.cols)
+	for ci := range views {
+		views[ci] = a.col(ci)
+	}
+
+	return views
+}
+
+// Clone returns a copy of the Matrix which shares no storage with it.  Cloning
+// a view returned by Slice detaches it from its parent.
+func (a Matrix[N]) Clone() Matrix[N] {
+	m := newMatrix[N](a.rows, a.cols)
+	for ci := 0; ci < a.cols; ci++ {
+		copy(m.col(ci), a.col(ci))
+	}
+
+	return m
+}
+
+// checkDimensionCount returns a *DimensionError if the vectors do not all have
+// the same number of components.
+func checkDimensionCount[N constraints.Float](op string, c []Vector[N]) error {
+	for i := 1; i < len(c); i++ {
+		if !c[i].sameDimension(c[0]) {
+			return c[0].dimensionError(op, c[i])
 		}
 	}
 
-	return true
+	return nil
 }
 
 // func NewMatrix(c []Vector) (Matrix, error) {
@@ -31,28 +101,148 @@ func sameDimensionCount[N constraints.Float](c []Vector[N]) bool {
 // 	return Matrix{}, errors.New("Vectors have different numbers of components")
 // }
 
+// FromRows returns a Matrix with the given rows, which are copied.  A
+// *DimensionError is returned if the rows do not all have the same dimension.
+// With no rows, the result is the 0 x 0 Matrix; use Zeros for a 0 x n one.
 func FromRows[N constraints.Float](rows []Vector[N]) (Matrix[N], error) {
 	// All vectors must have the same number of components
-	if sameDimensionCount(rows) {
-		cols := make([]Vector[N], len(rows[0]))
-		for ci, _ := range cols {
-			cols[ci] = make(Vector[N], len(rows))
-			for ri, _ := range rows {
-				cols[ci][ri] = rows[ri][ci]
-			}
-		}
+	if err := checkDimensionCount("FromRows", rows); err != nil {
+		return Matrix[N]{}, err
+	}
+
+	m := newMatrix[N](len(rows), 0)
+	if len(rows) > 0 {
+		m = newMatrix[N](len(rows), len(rows[0]))
+	}
 
-		return Matrix[N]{columns: cols}, nil
+	for ri, row := range rows {
+		for ci, val := range row {
+			m.data[ci*m.stride+ri] = val
+		}
 	}
-	return Matrix[N]{}, errors.New("Vectors have different numbers of components")
+
+	return m, nil
 }
 
+// FromColumns returns a Matrix with the given columns, which are copied.  A
+// *DimensionError is returned if the columns do not all have the same
+// dimension.  With no columns, the result is the 0 x 0 Matrix; use Zeros for an
+// n x 0 one.
 func FromColumns[N constraints.Float](c []Vector[N]) (Matrix[N], error) {
 	// All vectors must have the same number of components
-	if sameDimensionCount(c) {
-		return Matrix[N]{columns: c}, nil
+	if err := checkDimensionCount("FromColumns", c); err != nil {
+		return Matrix[N]{}, err
+	}
+
+	return fromColumns(c), nil
+}
+
+// FromColumnMajor returns a rows x cols Matrix which uses data, holding its
+// columns one after another, as its storage.  It is the no-copy counterpart of
+// FromColumns: because the Matrix's columns are contiguous, a []Vector cannot
+// be adopted without copying, but a column-major slice can.  No copy is
+// made: changes to data change the Matrix, and vice versa, so the caller should
+// give up data to the Matrix.  A *DimensionError is returned unless data has
+// exactly rows*cols components.
+func FromColumnMajor[N constraints.Float](rows, cols int, data []N) (Matrix[N], error) {
+	if rows < 0 || cols < 0 || len(data) != rows*cols {
+		return Matrix[N]{}, &DimensionError{
+			Op:       "FromColumnMajor",
+			Expected: Shape{Rows: rows * cols, Columns: 1},
+			Actual:   Shape{Rows: len(data), Columns: 1},
+		}
+	}
+
+	return Matrix[N]{data: data[:len(data):len(data)], rows: rows, cols: cols, stride: rows}, nil
+}
+
+// Freeze returns an immutable copy of the Matrix.  Every method which would
+// modify the copy - Set, ReplaceRow, MultiplyRow, the in-place element-wise
+// operations and so on - returns ErrImmutable instead, as does ColumnView, whose
+// result could be used to modify it.  Views of the copy returned by Slice are
+// immutable too.  Methods which return a new Matrix, such as Transpose, Clone
+// and Product, return a mutable one.
+func (a Matrix[N]) Freeze() Matrix[N] {
+	frozen := a.Clone()
+	frozen.frozen = true
+	return frozen
+}
+
+// IsFrozen returns true if the Matrix is immutable; see Freeze.
+func (a Matrix[N]) IsFrozen() bool {
+	return a.frozen
+}
+
+// At returns the entry at the specified row and column.  An *IndexError is
+// returned if either index is out of range.
+func (a Matrix[N]) At(rowIndex, columnIndex int) (N, error) {
+	if !a.isValidRowIndex(rowIndex) {
+		return 0, a.rowIndexError("At", rowIndex)
+	}
+
+	if !a.isValidColumnIndex(columnIndex) {
+		return 0, a.columnIndexError("At", columnIndex)
+	}
+
+	return a.data[columnIndex*a.stride+rowIndex], nil
+}
+
+// Set sets the entry at the specified row and column to val.  An *IndexError is
+// returned if either index is out of range, in which case the Matrix is
+// unchanged.
+func (a Matrix[N]) Set(rowIndex, columnIndex int, val N) error {
+	if a.frozen {
+		return ErrImmutable
+	}
+
+	if !a.isValidRowIndex(rowIndex) {
+		return a.rowIndexError("Set", rowIndex)
+	}
+
+	if !a.isValidColumnIndex(columnIndex) {
+		return a.columnIndexError("Set", columnIndex)
+	}
+
+	a.data[columnIndex*a.stride+rowIndex] = val
+	return nil
+}
+
+// Slice returns a view of the rows [r0, r1) and columns [c0, c1) of the Matrix.
+// The view shares storage with the Matrix: changes made through either, by
+// ReplaceRow, MultiplyRow, SwapRows, the in-place element-wise operations and so
+// on, are visible in both.  All other methods work on a view just as on any
+// Matrix; in particular a.Slice(i, i+1, 0, cols) is a view of row i.  Use Clone
+// to obtain an independent copy.
+//
+// An *IndexError is returned unless 0 <= r0 <= r1 <= rows and
+// 0 <= c0 <= c1 <= cols.
+func (a Matrix[N]) Slice(r0, r1, c0, c1 int) (Matrix[N], error) {
+	if r0 < 0 || r0 > a.rows {
+		return Matrix[N]{}, &IndexError{Op: "Slice", Index: r0, Limit: a.rows + 1}
+	}
+
+	if r1 < r0 || r1 > a.rows {
+		return Matrix[N]{}, &IndexError{Op: "Slice", Index: r1, Limit: a.rows + 1}
+	}
+
+	if c0 < 0 || c0 > a.cols {
+		return Matrix[N]{}, &IndexError{Op: "Slice", Index: c0, Limit: a.cols + 1}
+	}
+
+	if c1 < c0 || c1 > a.cols {
+		return Matrix[N]{}, &IndexError{Op: "Slice", Index: c1, Limit: a.cols + 1}
 	}
-	return Matrix[N]{}, errors.New("Vectors have different numbers of components")
+
+	view := Matrix[N]{rows: r1 - r0, cols: c1 - c0, stride: a.stride, frozen: a.frozen}
+	if view.cols > 0 {
+		// The view's storage runs from its first entry to its last, and
+		// is capped there so that nothing beyond it can be reached.
+		start := c0*a.stride + r0
+		end := start + (view.cols-1)*a.stride + view.rows
+		view.data = a.data[start:end:end]
+	}
+
+	return view, nil
 }
 
 // Row returns the specified row as a Vector.  Returns nil if the index is out of bounds.
@@ -60,12 +250,12 @@ func FromColumns[N constraints.Float](c []Vector[N]) (Matrix[N], error) {
 // source Matrix.
 func (a Matrix[N]) Row(rowIndex int) (Vector[N], error) {
 	if !a.isValidRowIndex(rowIndex) {
-		return nil, errors.New("Row index out of bounds")
+		return nil, a.rowIndexError("Row", rowIndex)
 	}
 
-	row := make(Vector[N], len(a.columns))
-	for ci, c := range a.columns {
-		row[ci] = c[rowIndex]
+	row := make(Vector[N], a.cols)
+	for ci := range row {
+		row[ci] = a.data[ci*a.stride+rowIndex]
 	}
 
 	return row, nil
@@ -75,18 +265,16 @@ func (a Matrix[N]) Row(rowIndex int) (Vector[N], error) {
 // Both Rows() and Columns() return **copies** of the component vectors - modifying
 // the returned slice does not affect the original Matrix.
 func (a Matrix[N]) Rows() []Vector[N] {
-	cols := a.Columns()
-
-	if cols == nil {
-		return nil
+	// All the rows share one backing array, to save allocations.
+	backing := make([]N, a.rows*a.cols)
+	rows := make([]Vector[N], a.rows)
+	for ri := range rows {
+		rows[ri] = backing[ri*a.cols : (ri+1)*a.cols : (ri+1)*a.cols]
 	}
 
-	rows := make([]Vector[N], len(cols[0]))
-	for ri, _ := range rows {
-		rows[ri] = make(Vector[N], len(cols))
-
-		for ci, c := range cols {
-			rows[ri][ci] = c[ri]
+	for ci := 0; ci < a.cols; ci++ {
+		for ri, val := range a.col(ci) {
+			rows[ri][ci] = val
 		}
 	}
 
@@ -98,27 +286,38 @@ func (a Matrix[N]) Rows() []Vector[N] {
 // source Matrix.
 func (a Matrix[N]) Column(columnIndex int) (Vector[N], error) {
 	if !a.isValidColumnIndex(columnIndex) {
-		return nil, errors.New("Column index out of bounds")
+		return nil, a.columnIndexError("Column", columnIndex)
 	}
 
-	col := make(Vector[N], len(a.columns[columnIndex]))
-	for compIdx, val := range a.columns[columnIndex] {
-		col[compIdx] = val
+	return append(Vector[N]{}, a.col(columnIndex)...), nil
+}
+
+// ColumnView returns the specified column as a Vector which shares storage with
+// the Matrix: changes to the Vector's components change the Matrix, and vice
+// versa.  Unlike Column, ColumnView does not allocate.  ErrImmutable is returned
+// if the Matrix is frozen.
+func (a Matrix[N]) ColumnView(columnIndex int) (Vector[N], error) {
+	if a.frozen {
+		return nil, ErrImmutable
 	}
 
-	return col, nil
+	if !a.isValidColumnIndex(columnIndex) {
+		return nil, a.columnIndexError("ColumnView", columnIndex)
+	}
+
+	return a.col(columnIndex), nil
 }
 
 // Columns returns the set of column vectors, left to right, consistituting the Matrix.
 // Both Rows() and Columns() return **copies** of the component vectors - modifying
 // the returned slice does not affect the original Matrix.
 func (a Matrix[N]) Columns() []Vector[N] {
-	cols := make([]Vector[N], len(a.columns))
-	for ci, c := range a.columns {
-		cols[ci] = make(Vector[N], len(c))
-		for compIdx, val := range c {
-			cols[ci][compIdx] = val
-		}
+	// All the columns share one backing array, to save allocations.
+	backing := make([]N, a.rows*a.cols)
+	cols := make([]Vector[N], a.cols)
+	for ci := range cols {
+		cols[ci] = backing[ci*a.rows : (ci+1)*a.rows : (ci+1)*a.rows]
+		copy(cols[ci], a.col(ci))
 	}
 	return cols
 }
@@ -127,47 +326,77 @@ func (a Matrix[N]) Columns() []Vector[N] {
 // An error is returned if either (a) the index is out of bounds or (b) the
 // Vector has the wrong dimension.
 func (a Matrix[N]) ReplaceRow(rowIndex int, src Vector[N]) error {
+	if a.frozen {
+		return ErrImmutable
+	}
+
 	if !a.isValidRowIndex(rowIndex) {
-		return errors.New("Row index out of bounds")
+		return a.rowIndexError("ReplaceRow", rowIndex)
 	}
 
-	if len(src) != len(a.columns) {
-		return errors.New("Vector has wrong dimension")
+	if len(src) != a.cols {
+		return &DimensionError{
+			Op:       "ReplaceRow",
+			Expected: Shape{Rows: a.cols, Columns: 1},
+			Actual:   Shape{Rows: len(src), Columns: 1},
+		}
 	}
 
-	for ci, c := range a.columns {
-		c[rowIndex] = src[ci]
+	for ci, val := range src {
+		a.data[ci*a.stride+rowIndex] = val
 	}
 
 	return nil
 }
 
 // ReplaceColumn replaces the column at the specified index with the supplied Vector.
+// The components of the Vector are copied into the Matrix.
 // An error is returned if either (a) the index is out of bounds or (b) the
 // Vector as the wrong demension.
 func (a Matrix[N]) ReplaceColumn(columnIndex int, src Vector[N]) error {
+	if a.frozen {
+		return ErrImmutable
+	}
+
 	if !a.isValidColumnIndex(columnIndex) {
-		return errors.New("Column index out of bounds")
+		return a.columnIndexError("ReplaceColumn", columnIndex)
 	}
 
-	if !src.sameDimension(a.columns[0]) {
-		return errors.New("Vector has wrong dimension")
+	if !src.sameDimension(a.col(columnIndex)) {
+		return a.col(columnIndex).dimensionError("ReplaceColumn", src)
 	}
 
-	a.columns[columnIndex] = src
+	copy(a.col(columnIndex), src)
 	return nil
 }
 
+// Transpose returns the transpose of the Matrix as a new Matrix.  Since the
+// Matrix is stored as columns, the columns of the transpose are simply the rows
+// of the original.
+func (a Matrix[N]) Transpose() Matrix[N] {
+	t := newMatrix[N](a.cols, a.rows)
+	for ci := 0; ci < a.cols; ci++ {
+		for ri, val := range a.col(ci) {
+			t.data[ri*t.stride+ci] = val
+		}
+	}
+
+	return t
+}
+
 // MultiplyRow multiplies the specified row by the given factor.
 // Returns an error if the row index is out of range.
 func (a Matrix[N]) MultiplyRow(rowIndex int, factor N) error {
+	if a.frozen {
+		return ErrImmutable
+	}
+
 	if !a.isValidRowIndex(rowIndex) {
-		return errors.New("Row index out of range")
+		return a.rowIndexError("MultiplyRow", rowIndex)
 	}
 
-	for _, col := range a.columns {
-		orig := col[rowIndex]
-		col[rowIndex] = orig * factor
+	for ci := 0; ci < a.cols; ci++ {
+		a.data[ci*a.stride+rowIndex] *= factor
 	}
 
 	return nil
@@ -176,13 +405,45 @@ func (a Matrix[N]) MultiplyRow(rowIndex int, factor N) error {
 // MultiplyColumn multiplies the specified column by the given factor.
 // Returns an error if the colun index is out of range.
 func (a Matrix[N]) MultiplyColumn(columnIndex int, factor N) error {
+	if a.frozen {
+		return ErrImmutable
+	}
+
 	if !a.isValidColumnIndex(columnIndex) {
-		return errors.New("Row index out of range")
+		return a.columnIndexError("MultiplyColumn", columnIndex)
 	}
-	a.columns[columnIndex].Multiply(factor)
+	a.col(columnIndex).Multiply(factor)
+	return nil
+}
+
+// SwapRows exchanges the two specified rows.
+// Returns an error if either row index is out of range.
+func (a Matrix[N]) SwapRows(i, j int) error {
+	if a.frozen {
+		return ErrImmutable
+	}
+
+	for _, index := range []int{i, j} {
+		if !a.isValidRowIndex(index) {
+			return a.rowIndexError("SwapRows", index)
+		}
+	}
+
+	a.swapRows(i, j)
 	return nil
 }
 
+func (a Matrix[N]) swapRows(i, j int) {
+	if i == j {
+		return
+	}
+
+	for ci := 0; ci < a.cols; ci++ {
+		col := a.col(ci)
+		col[i], col[j] = col[j], col[i]
+	}
+}
+
 func (a Matrix[N]) isValidRowIndex(index int) bool {
 	return a.isValidIndex(index, true)
 }
@@ -191,52 +452,128 @@ func (a Matrix[N]) isValidColumnIndex(index int) bool {
 	return a.isValidIndex(index, false)
 }
 
+// rowIndexError returns an *IndexError for an invalid row index.
+func (a Matrix[N]) rowIndexError(op string, index int) error {
+	rows, _ := a.Dims()
+	return &IndexError{Op: op, Index: index, Limit: rows}
+}
+
+// columnIndexError returns an *IndexError for an invalid column index.
+func (a Matrix[N]) columnIndexError(op string, index int) error {
+	_, cols := a.Dims()
+	return &IndexError{Op: op, Index: index, Limit: cols}
+}
+
 func (a Matrix[N]) isValidIndex(index int, isRowIndex bool) bool {
 	if index < 0 {
 		return false
 	}
 
 	if isRowIndex {
-		if index >= len(a.columns[0]) {
+		if index >= a.rows {
 			return false
 		}
-	} else if index >= len(a.columns) {
+	} else if index >= a.cols {
 		return false
 	}
 
 	return true
 }
 
-func (a Matrix[N]) eliminate(srcIdx, destIdx int) {
+// eliminate subtracts a multiple of the source row from the destination row so
+// that the destination row has a zero in the pivot column.  The source row must
+// have a nonzero entry in the pivot column.
+func (a Matrix[N]) eliminate(srcIdx, destIdx, pivotColumn int) {
+	pc := a.col(pivotColumn)
+	a.combineRows(srcIdx, destIdx, -pc[destIdx]/pc[srcIdx])
+	pc[destIdx] = 0
+}
+
+// combineRows adds factor times the source row to the destination row, in place.
+func (a Matrix[N]) combineRows(srcIdx, destIdx int, factor N) {
+	for ci := 0; ci < a.cols; ci++ {
+		c := a.col(ci)
+		c[destIdx] += factor * c[srcIdx]
+	}
+}
 
+// Product multiplies two matrices.  a is the matrix on the left, b on the right.
+// Each column of the product is a linear combination of the columns of a, weighted
+// by the components of the corresponding column of b.  A *DimensionError is returned
+// if the number of columns in a does not equal the number of rows in b.
+//
+// Product uses the default ProductOptions: large products are computed in
+// cache-sized blocks spread across GOMAXPROCS goroutines.  Use ProductWith to
+// tune this.
+func (a Matrix[N]) Product(b Matrix[N]) (Matrix[N], error) {
+	return a.ProductWith(b, ProductOptions{})
 }
 
-func (a Matrix[N]) combineRows(srcIdx, destIdx int, factor N) Matrix[N] {
-	result := Matrix[N]{
-		columns: make([]Vector[N], len(a.columns)),
+// Determinant returns the determinant of the Matrix, computed by LU
+// decomposition.  No tolerance is applied, so a badly scaled Matrix gets its
+// true determinant, however small, rather than zero.  ErrNotSquare is returned
+// if the Matrix is not square.
+func (a Matrix[N]) Determinant() (N, error) {
+	f, err := a.LU()
+	if err != nil {
+		return 0, err
 	}
 
-	for ci, srcColumn := range a.columns {
-		result.columns[ci] = make(Vector[N], len(srcColumn))
-		for compIdx, val := range srcColumn {
-			if compIdx != destIdx {
-				result.columns[ci][compIdx] = val
-			} else {
-				result.columns[ci][compIdx] = val + (factor * srcColumn[srcIdx])
-			}
-		}
+	return f.Determinant(), nil
+}
+
+// Inverse returns the inverse of the Matrix, computed by LU decomposition.
+// ErrNotSquare is returned if the Matrix is not square, and ErrSingular if it has
+// no inverse.
+func (a Matrix[N]) Inverse() (Matrix[N], error) {
+	f, err := a.LU()
+	if err != nil {
+		return Matrix[N]{}, err
 	}
 
-	return result
+	return f.Inverse()
 }
 
-// Product multiplies two matrices.  a is the matrix on the left, b on the right.
-// The matrix returned is a combination of the columns of a and of the rows of b.
-// Not yet implemented
-func (a Matrix[N]) Product(b Matrix[N]) (Matrix[N], error) {
-	return Matrix[N]{}, nil
+// Trace returns the sum of the entries on the main diagonal of the Matrix.
+// ErrNotSquare is returned if the Matrix is not square.
+func (a Matrix[N]) Trace() (N, error) {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return 0, ErrNotSquare
+	}
+
+	var t N
+	for ci := 0; ci < cols; ci++ {
+		t += a.data[ci*a.stride+ci]
+	}
+
+	return t, nil
 }
 
+// canBeMultiplied returns true if the number of columns in a matches the number
+// of rows in b.
 func canBeMultiplied[N constraints.Float](a, b Matrix[N]) bool {
-	return true
+	_, aCols := a.Dims()
+	bRows, _ := b.Dims()
+	return aCols == bRows
+}
+
+// Dims returns the number of rows and columns in the Matrix.
+func (a Matrix[N]) Dims() (rows, cols int) {
+	return a.rows, a.cols
+}
+
+// RowCount returns the number of rows in the Matrix.
+func (a Matrix[N]) RowCount() int {
+	return a.rows
+}
+
+// ColumnCount returns the number of columns in the Matrix.
+func (a Matrix[N]) ColumnCount() int {
+	return a.cols
+}
+
+// IsSquare returns true if the Matrix has as many rows as columns.
+func (a Matrix[N]) IsSquare() bool {
+	return a.rows == a.cols
 }
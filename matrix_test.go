@@ -1,6 +1,9 @@
 package wyvern_test
 
 import (
+	"errors"
+	"testing"
+
 	"github.com/ScarletTanager/wyvern"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -91,7 +94,13 @@ var _ = Describe("Matrix", func() {
 				It("Returns an empty Matrix and an error", func() {
 					m, e := wyvern.FromRows(c)
 					Expect(m).To(Equal(wyvern.Matrix[float64]{}))
-					Expect(e).To(HaveOccurred())
+					Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+
+					var de *wyvern.DimensionError
+					Expect(errors.As(e, &de)).To(BeTrue())
+					Expect(de.Op).To(Equal("FromRows"))
+					Expect(de.Expected).To(Equal(wyvern.Shape{Rows: 3, Columns: 1}))
+					Expect(de.Actual).To(Equal(wyvern.Shape{Rows: 2, Columns: 1}))
 				})
 			})
 
@@ -99,7 +108,7 @@ var _ = Describe("Matrix", func() {
 				It("Returns an empty Matrix and an error", func() {
 					m, e := wyvern.FromColumns(c)
 					Expect(m).To(Equal(wyvern.Matrix[float64]{}))
-					Expect(e).To(HaveOccurred())
+					Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
 				})
 			})
 		})
@@ -155,7 +164,11 @@ var _ = Describe("Matrix", func() {
 				It("Returns nil and an error", func() {
 					row, e := mt.Row(rowIndex)
 					Expect(row).To(BeNil())
-					Expect(e).To(HaveOccurred())
+					Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+
+					var ie *wyvern.IndexError
+					Expect(errors.As(e, &ie)).To(BeTrue())
+					Expect(*ie).To(Equal(wyvern.IndexError{Op: "Row", Index: 2, Limit: 2}))
 				})
 			})
 		})
@@ -220,6 +233,33 @@ var _ = Describe("Matrix", func() {
 			})
 		})
 
+		Describe("ColumnView", func() {
+			It("Returns the specified column, sharing storage with the matrix", func() {
+				col, e := mt.ColumnView(1)
+				Expect(e).NotTo(HaveOccurred())
+				Expect(col).To(Equal(wyvern.Vector[float64]{2, 5, 7}))
+
+				col.Multiply(2)
+				Expect(mt.Column(1)).To(Equal(wyvern.Vector[float64]{4, 10, 14}))
+
+				Expect(mt.MultiplyRow(0, -1)).To(Succeed())
+				Expect(col).To(Equal(wyvern.Vector[float64]{-4, 10, 14}))
+			})
+
+			It("Cannot be appended to in place of the next column", func() {
+				col, _ := mt.ColumnView(0)
+				_ = append(col, 99)
+				Expect(mt.Column(1)).To(Equal(wyvern.Vector[float64]{2, 5, 7}))
+			})
+
+			When("The column index is out of bounds", func() {
+				It("Returns an IndexError", func() {
+					_, e := mt.ColumnView(3)
+					Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+				})
+			})
+		})
+
 		Describe("Columns", func() {
 			It("Returns a copy of the column vectors", func() {
 				colsBefore := mt.Columns()
@@ -229,6 +269,11 @@ var _ = Describe("Matrix", func() {
 				Expect(colsAfter).To(Equal(c))
 				Expect(colsBefore).NotTo(Equal(colsAfter))
 			})
+
+			It("Allocates the columns together", func() {
+				Expect(testing.AllocsPerRun(10, func() { mt.Columns() })).To(BeNumerically("<=", 2))
+				Expect(testing.AllocsPerRun(10, func() { mt.Rows() })).To(BeNumerically("<=", 2))
+			})
 		})
 
 		Describe("ReplaceRow", func() {
@@ -343,8 +388,313 @@ var _ = Describe("Matrix", func() {
 			})
 		})
 
-		Describe("Product", func() {
+		Describe("Determinant", func() {
+			It("Returns the determinant", func() {
+				d, e := mt.Determinant()
+				Expect(e).NotTo(HaveOccurred())
+				Expect(d).To(BeNumerically("~", -99, 1e-10))
+			})
+
+			When("The matrix is singular", func() {
+				BeforeEach(func() {
+					c = []wyvern.Vector[float64]{
+						{1, 2, 3},
+						{2, 4, 6},
+						{0, 1, 5},
+					}
+				})
+
+				It("Returns zero", func() {
+					d, e := mt.Determinant()
+					Expect(e).NotTo(HaveOccurred())
+					Expect(d).To(BeZero())
+				})
+			})
+
+			When("The matrix is badly scaled but nonsingular", func() {
+				BeforeEach(func() {
+					c = []wyvern.Vector[float64]{
+						{1e-8, 0},
+						{0, 1e8},
+					}
+				})
+
+				It("Returns the determinant rather than zero", func() {
+					d, e := mt.Determinant()
+					Expect(e).NotTo(HaveOccurred())
+					Expect(d).To(Equal(1.0))
+				})
+			})
+
+			When("The matrix is not square", func() {
+				BeforeEach(func() {
+					c = []wyvern.Vector[float64]{
+						{1, 2, 3},
+						{2, 4, 6},
+					}
+				})
+
+				It("Returns ErrNotSquare", func() {
+					_, e := mt.Determinant()
+					Expect(e).To(MatchError(wyvern.ErrNotSquare))
+				})
+			})
+		})
+
+		Describe("Inverse", func() {
+			It("Returns the inverse", func() {
+				inv, e := mt.Inverse()
+				Expect(e).NotTo(HaveOccurred())
+
+				product, _ := mt.Product(inv)
+				expectVectorsNear(product.Rows(), []wyvern.Vector[float64]{
+					{1, 0, 0},
+					{0, 1, 0},
+					{0, 0, 1},
+				}, 1e-12)
+			})
+
+			When("The matrix is singular", func() {
+				BeforeEach(func() {
+					c = []wyvern.Vector[float64]{
+						{1, 2, 3},
+						{2, 4, 6},
+						{0, 1, 5},
+					}
+				})
+
+				It("Returns ErrSingular", func() {
+					_, e := mt.Inverse()
+					Expect(e).To(MatchError(wyvern.ErrSingular))
+				})
+			})
+
+			When("The matrix is badly scaled but nonsingular", func() {
+				BeforeEach(func() {
+					c = []wyvern.Vector[float64]{
+						{1e-20, 0},
+						{0, 1},
+					}
+				})
+
+				It("Returns the inverse rather than ErrSingular", func() {
+					inv, e := mt.Inverse()
+					Expect(e).NotTo(HaveOccurred())
+					Expect(inv.Rows()).To(Equal([]wyvern.Vector[float64]{
+						{1e20, 0},
+						{0, 1},
+					}))
+				})
+			})
+
+			When("The matrix is not square", func() {
+				BeforeEach(func() {
+					c = []wyvern.Vector[float64]{
+						{1, 2, 3},
+						{2, 4, 6},
+					}
+				})
+
+				It("Returns ErrNotSquare", func() {
+					_, e := mt.Inverse()
+					Expect(e).To(MatchError(wyvern.ErrNotSquare))
+				})
+			})
+		})
+
+		Describe("At and Set", func() {
+			It("Reads and writes entries by row and column", func() {
+				Expect(mt.At(1, 2)).To(Equal(10.0))
+				Expect(mt.Set(1, 2, -1)).To(Succeed())
+				Expect(mt.At(1, 2)).To(Equal(-1.0))
+				Expect(mt.Column(2)).To(Equal(wyvern.Vector[float64]{13, -1, -4}))
+			})
+
+			It("Writes through a Slice to its parent", func() {
+				view := must(mt.Slice(1, 3, 1, 3))
+				Expect(view.At(0, 0)).To(Equal(5.0))
+				Expect(view.Set(1, 1, 0)).To(Succeed())
+				Expect(mt.At(2, 2)).To(Equal(0.0))
+			})
+
+			When("An index is out of range", func() {
+				It("Returns an IndexError and leaves the matrix unchanged", func() {
+					_, e := mt.At(3, 0)
+					Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+					_, e = mt.At(0, -1)
+					Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+
+					before := mt.Rows()
+					e = mt.Set(0, 3, 1)
+					var ie *wyvern.IndexError
+					Expect(errors.As(e, &ie)).To(BeTrue())
+					Expect(ie.Op).To(Equal("Set"))
+					Expect(ie.Index).To(Equal(3))
+					Expect(mt.Rows()).To(Equal(before))
+				})
+			})
+		})
+
+		Describe("Dims", func() {
+			It("Reports the shape of a square matrix", func() {
+				rows, cols := mt.Dims()
+				Expect(rows).To(Equal(3))
+				Expect(cols).To(Equal(3))
+				Expect(mt.IsSquare()).To(BeTrue())
+			})
+
+			When("The matrix is not square", func() {
+				BeforeEach(func() {
+					c = []wyvern.Vector[float64]{
+						{1, 2, 3},
+						{2, 4, 6},
+					}
+				})
+
+				It("Reports the number of rows and columns", func() {
+					rows, cols := mt.Dims()
+					Expect(rows).To(Equal(3))
+					Expect(cols).To(Equal(2))
+					Expect(mt.RowCount()).To(Equal(3))
+					Expect(mt.ColumnCount()).To(Equal(2))
+					Expect(mt.IsSquare()).To(BeFalse())
+				})
+			})
+		})
+
+		Describe("Trace", func() {
+			It("Returns the sum of the diagonal entries", func() {
+				t, e := mt.Trace()
+				Expect(e).NotTo(HaveOccurred())
+				Expect(t).To(Equal(2.0))
+			})
+
+			When("The matrix is not square", func() {
+				BeforeEach(func() {
+					c = []wyvern.Vector[float64]{
+						{1, 2, 3},
+						{2, 4, 6},
+					}
+				})
+
+				It("Returns ErrNotSquare", func() {
+					_, e := mt.Trace()
+					Expect(e).To(MatchError(wyvern.ErrNotSquare))
+				})
+			})
+		})
+
+		Describe("Slice", func() {
+			var view wyvern.Matrix[float64]
+
+			BeforeEach(func() {
+				c = []wyvern.Vector[float64]{
+					{1, 5, 9, 13},
+					{2, 6, 10, 14},
+					{3, 7, 11, 15},
+					{4, 8, 12, 16},
+				}
+			})
+
+			JustBeforeEach(func() {
+				var e error
+				view, e = mt.Slice(1, 3, 1, 4)
+				Expect(e).NotTo(HaveOccurred())
+			})
+
+			It("Returns the specified block", func() {
+				Expect(view.Rows()).To(Equal([]wyvern.Vector[float64]{
+					{6, 7, 8},
+					{10, 11, 12},
+				}))
+				Expect(view.Column(2)).To(Equal(wyvern.Vector[float64]{8, 12}))
+				Expect(view.Row(1)).To(Equal(wyvern.Vector[float64]{10, 11, 12}))
+			})
+
+			It("Shares storage with the parent", func() {
+				Expect(view.MultiplyRow(0, 10)).To(Succeed())
+				Expect(view.ReplaceRow(1, wyvern.Vector[float64]{-1, -2, -3})).To(Succeed())
+				Expect(mt.Rows()).To(Equal([]wyvern.Vector[float64]{
+					{1, 2, 3, 4},
+					{5, 60, 70, 80},
+					{9, -1, -2, -3},
+					{13, 14, 15, 16},
+				}))
+
+				mt.MultiplyColumn(3, 0)
+				Expect(view.Column(2)).To(Equal(wyvern.Vector[float64]{0, 0}))
+			})
+
+			It("Does not reach entries outside the block", func() {
+				Expect(view.ReplaceColumn(0, wyvern.Vector[float64]{0, 0})).To(Succeed())
+				Expect(view.ScaleInPlace(0)).To(Succeed())
+				Expect(mt.Column(0)).To(Equal(wyvern.Vector[float64]{1, 5, 9, 13}))
+				Expect(mt.Row(0)).To(Equal(wyvern.Vector[float64]{1, 2, 3, 4}))
+				Expect(mt.Row(3)).To(Equal(wyvern.Vector[float64]{13, 14, 15, 16}))
+			})
+
+			It("Supports the other Matrix operations", func() {
+				Expect(view.Transpose().Columns()).To(Equal(view.Rows()))
+
+				p, e := view.Product(must(wyvern.FromColumns([]wyvern.Vector[float64]{{1, 0, -1}})))
+				Expect(e).NotTo(HaveOccurred())
+				Expect(p.Column(0)).To(Equal(wyvern.Vector[float64]{-2, -2}))
+
+				square, _ := mt.Slice(0, 2, 2, 4)
+				Expect(square.Determinant()).To(BeNumerically("~", 3*8-4*7, 1e-12))
+			})
+
+			It("Can be sliced again", func() {
+				inner, e := view.Slice(1, 2, 0, 2)
+				Expect(e).NotTo(HaveOccurred())
+				Expect(inner.Rows()).To(Equal([]wyvern.Vector[float64]{{10, 11}}))
+			})
+
+			It("Allows empty views", func() {
+				empty, e := mt.Slice(2, 2, 1, 3)
+				Expect(e).NotTo(HaveOccurred())
+				Expect(empty.Columns()).To(Equal([]wyvern.Vector[float64]{{}, {}}))
+			})
+
+			When("The bounds are invalid", func() {
+				It("Returns an IndexError", func() {
+					for _, b := range [][4]int{{-1, 2, 0, 1}, {0, 5, 0, 1}, {2, 1, 0, 1}, {0, 1, 3, 2}, {0, 1, 0, 5}} {
+						_, e := mt.Slice(b[0], b[1], b[2], b[3])
+						Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange), "bounds %v", b)
+					}
+				})
+			})
 
+			Describe("Clone", func() {
+				It("Detaches the view from its parent", func() {
+					detached := view.Clone()
+					Expect(detached.Rows()).To(Equal(view.Rows()))
+
+					detached.MultiplyRow(0, 0)
+					Expect(view.Row(0)).To(Equal(wyvern.Vector[float64]{6, 7, 8}))
+				})
+			})
+		})
+
+		Describe("Transpose", func() {
+			BeforeEach(func() {
+				c = []wyvern.Vector[float64]{
+					{1, 2, 3},
+					{4, 5, 6},
+				}
+			})
+
+			It("Returns a matrix whose rows are the columns of the original", func() {
+				t := mt.Transpose()
+				Expect(t.Rows()).To(Equal(c))
+				Expect(t.Columns()).To(Equal(mt.Rows()))
+			})
+
+			It("Returns a copy", func() {
+				t := mt.Transpose()
+				t.MultiplyRow(0, 2)
+				Expect(mt.Columns()).To(Equal(c))
+			})
 		})
 
 		Describe("MultiplyRow", func() {
@@ -375,7 +725,7 @@ var _ = Describe("Matrix", func() {
 
 				It("Returns an error and does not modify the matrix", func() {
 					e := mt.MultiplyRow(rowIndex, 3.0)
-					Expect(e).To(HaveOccurred())
+					Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
 					Expect(mt.Rows()).To(Equal(originalRows))
 				})
 			})
@@ -411,12 +761,46 @@ var _ = Describe("Matrix", func() {
 
 				It("Returns an error and does not modify the matrix", func() {
 					e := mt.MultiplyColumn(columnIndex, factor)
-					Expect(e).To(HaveOccurred())
+					Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+					Expect(e.Error()).To(ContainSubstring("MultiplyColumn"))
 					Expect(mt.Columns()).To(Equal(originalColumns))
 				})
 			})
 		})
 
+		Describe("SwapRows", func() {
+			var (
+				i, j int
+			)
+
+			BeforeEach(func() {
+				i, j = 0, 2
+			})
+
+			It("Exchanges the two rows", func() {
+				e := mt.SwapRows(i, j)
+				Expect(e).NotTo(HaveOccurred())
+				Expect(mt.Rows()).To(Equal([]wyvern.Vector[float64]{
+					{9, 7, -4},
+					{4, 5, 10},
+					{1, 2, 13},
+				}))
+			})
+
+			When("A row index is out of bounds", func() {
+				BeforeEach(func() {
+					j = 3
+				})
+
+				It("Returns an error and does not modify the matrix", func() {
+					before := mt.Rows()
+					e := mt.SwapRows(i, j)
+					Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+					Expect(mt.Rows()).To(Equal(before))
+				})
+			})
+		})
+
 		Describe("Product", func() {
 			var (
 				mtA, mtB            wyvern.Matrix[float64]
@@ -449,9 +833,244 @@ var _ = Describe("Matrix", func() {
 			})
 
 			It("Returns the matrix product of the two matrices", func() {
-				product, _ := mtA.Product(mtB)
+				product, e := mtA.Product(mtB)
+				Expect(e).NotTo(HaveOccurred())
 				Expect(product.Columns()).To(Equal(colsU))
 			})
+
+			When("The matrices are not square", func() {
+				BeforeEach(func() {
+					colsA = []wyvern.Vector[float64]{
+						{1, 4},
+						{2, 5},
+						{3, 6},
+					}
+
+					colsB = []wyvern.Vector[float64]{
+						{1, 0, 2},
+						{-1, 3, 1},
+					}
+				})
+
+				It("Returns a product with the rows of a and the columns of b", func() {
+					product, e := mtA.Product(mtB)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(product.Rows()).To(Equal([]wyvern.Vector[float64]{
+						{7, 8},
+						{16, 17},
+					}))
+				})
+			})
+
+			When("The number of columns in a does not match the number of rows in b", func() {
+				BeforeEach(func() {
+					colsB = []wyvern.Vector[float64]{
+						{2, 0},
+						{1, 4},
+					}
+				})
+
+				It("Returns an empty Matrix and a DimensionError", func() {
+					product, e := mtA.Product(mtB)
+					Expect(product).To(Equal(wyvern.Matrix[float64]{}))
+					Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+
+					var de *wyvern.DimensionError
+					Expect(errors.As(e, &de)).To(BeTrue())
+					Expect(de.Op).To(Equal("Product"))
+					Expect(de.Expected).To(Equal(wyvern.Shape{Rows: 3, Columns: 2}))
+					Expect(de.Actual).To(Equal(wyvern.Shape{Rows: 2, Columns: 2}))
+				})
+			})
+		})
+	})
+
+	Describe("Ownership", func() {
+		It("Copies the vectors passed to constructors and ReplaceColumn", func() {
+			col := wyvern.Vector[float64]{1, 2}
+			m := must(wyvern.FromColumns([]wyvern.Vector[float64]{col, {3, 4}}))
+			col[0] = 100
+			Expect(m.At(0, 0)).To(Equal(1.0))
+
+			row := wyvern.Vector[float64]{5, 6}
+			m = must(wyvern.FromRows([]wyvern.Vector[float64]{row, {7, 8}}))
+			row[0] = 100
+			Expect(m.At(0, 0)).To(Equal(5.0))
+
+			Expect(m.ReplaceColumn(1, col)).To(Succeed())
+			col[1] = -1
+			Expect(m.Column(1)).To(Equal(wyvern.Vector[float64]{100, 2}))
+		})
+
+		Describe("FromColumnMajor", func() {
+			It("Uses the supplied slice as storage", func() {
+				data := []float64{1, 2, 3, 4, 5, 6}
+				m, e := wyvern.FromColumnMajor(2, 3, data)
+				Expect(e).NotTo(HaveOccurred())
+				Expect(m.Rows()).To(Equal([]wyvern.Vector[float64]{{1, 3, 5}, {2, 4, 6}}))
+
+				data[5] = 60
+				Expect(m.At(1, 2)).To(Equal(60.0))
+				Expect(m.Set(0, 0, 10)).To(Succeed())
+				Expect(data[0]).To(Equal(10.0))
+			})
+
+			When("The slice has the wrong length", func() {
+				It("Returns a DimensionError", func() {
+					_, e := wyvern.FromColumnMajor(2, 3, []float64{1, 2, 3, 4, 5})
+					Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+				})
+			})
+		})
+
+		Describe("Freeze", func() {
+			var (
+				m, frozen wyvern.Matrix[float64]
+			)
+
+			BeforeEach(func() {
+				m = must(wyvern.FromRows([]wyvern.Vector[float64]{{1, 2}, {3, 4}}))
+				frozen = m.Freeze()
+			})
+
+			It("Returns an immutable copy", func() {
+				Expect(frozen.IsFrozen()).To(BeTrue())
+				Expect(m.IsFrozen()).To(BeFalse())
+				Expect(frozen.Rows()).To(Equal(m.Rows()))
+
+				Expect(m.Set(0, 0, 10)).To(Succeed())
+				Expect(frozen.At(0, 0)).To(Equal(1.0))
+			})
+
+			It("Rejects every mutation with ErrImmutable", func() {
+				before := frozen.Rows()
+				_, e := frozen.ColumnView(0)
+				Expect(e).To(MatchError(wyvern.ErrImmutable))
+
+				for _, e := range []error{
+					frozen.Set(0, 0, 10),
+					frozen.ReplaceRow(0, wyvern.Vector[float64]{0, 0}),
+					frozen.ReplaceColumn(0, wyvern.Vector[float64]{0, 0}),
+					frozen.MultiplyRow(0, 2),
+					frozen.MultiplyColumn(0, 2),
+					frozen.SwapRows(0, 1),
+					frozen.AddInPlace(m),
+					frozen.SubtractInPlace(m),
+					frozen.HadamardInPlace(m),
+					frozen.ScaleInPlace(2),
+					frozen.ApplyInPlace(func(x float64) float64 { return -x }),
+				} {
+					Expect(e).To(MatchError(wyvern.ErrImmutable))
+				}
+				Expect(frozen.Rows()).To(Equal(before))
+			})
+
+			It("Freezes views of a frozen Matrix", func() {
+				view := must(frozen.Slice(0, 1, 0, 2))
+				Expect(view.IsFrozen()).To(BeTrue())
+				Expect(view.Set(0, 0, 10)).To(MatchError(wyvern.ErrImmutable))
+			})
+
+			It("Returns mutable results from other operations", func() {
+				clone := frozen.Clone()
+				Expect(clone.IsFrozen()).To(BeFalse())
+				Expect(clone.Set(0, 0, 10)).To(Succeed())
+				Expect(frozen.At(0, 0)).To(Equal(1.0))
+
+				sum := must(frozen.Add(frozen))
+				Expect(sum.IsFrozen()).To(BeFalse())
+				Expect(frozen.Transpose().IsFrozen()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Empty matrices", func() {
+		It("Treats the zero value as the 0 x 0 Matrix", func() {
+			var z wyvern.Matrix[float64]
+			rows, cols := z.Dims()
+			Expect(rows).To(Equal(0))
+			Expect(cols).To(Equal(0))
+			Expect(z.Rows()).To(BeEmpty())
+			Expect(z.Columns()).To(BeEmpty())
+			Expect(z.IsSquare()).To(BeTrue())
+
+			_, e := z.Row(0)
+			Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+			_, e = z.At(0, 0)
+			Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+
+			Expect(wyvern.FromRows[float64](nil)).To(Equal(z.Clone()))
+			Expect(wyvern.FromColumns[float64](nil)).To(Equal(z.Clone()))
+		})
+
+		It("Keeps the shape of matrices with no rows or no columns", func() {
+			tall := must(wyvern.FromRows([]wyvern.Vector[float64]{{}, {}, {}}))
+			rows, cols := tall.Dims()
+			Expect(rows).To(Equal(3))
+			Expect(cols).To(Equal(0))
+			Expect(tall.Rows()).To(Equal([]wyvern.Vector[float64]{{}, {}, {}}))
+			Expect(tall.Row(2)).To(BeEmpty())
+			Expect(tall.Columns()).To(BeEmpty())
+
+			wide := tall.Transpose()
+			rows, cols = wide.Dims()
+			Expect(rows).To(Equal(0))
+			Expect(cols).To(Equal(3))
+			Expect(wide.Columns()).To(Equal([]wyvern.Vector[float64]{{}, {}, {}}))
+			Expect(wide.Column(1)).To(BeEmpty())
+			Expect(wide.Rows()).To(BeEmpty())
+			Expect(wyvern.FromColumns(wide.Columns())).To(Equal(wide))
+		})
+
+		It("Multiplies over an empty inner dimension", func() {
+			outer := must(wyvern.Zeros[float64](3, 0).Product(wyvern.Zeros[float64](0, 2)))
+			Expect(outer).To(Equal(wyvern.Zeros[float64](3, 2)))
+
+			blocked, e := wyvern.Zeros[float64](3, 0).ProductWith(wyvern.Zeros[float64](0, 2), wyvern.ProductOptions{Threshold: 1, BlockSize: 1})
+			Expect(e).NotTo(HaveOccurred())
+			Expect(blocked).To(Equal(outer))
+
+			inner := must(wyvern.Zeros[float64](0, 3).Product(wyvern.Ones[float64](3, 2)))
+			rows, cols := inner.Dims()
+			Expect(rows).To(Equal(0))
+			Expect(cols).To(Equal(2))
+		})
+
+		It("Slices down to empty views", func() {
+			m := wyvern.Ones[float64](3, 3)
+			view := must(m.Slice(1, 1, 0, 3))
+			Expect(view.Columns()).To(Equal([]wyvern.Vector[float64]{{}, {}, {}}))
+			Expect(view.Add(view)).To(Equal(wyvern.Zeros[float64](0, 3)))
+		})
+
+		It("Decomposes empty matrices into factors of the right shape", func() {
+			Expect(wyvern.Matrix[float64]{}.Determinant()).To(Equal(1.0))
+
+			f, e := wyvern.Zeros[float64](0, 3).SVD()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(f.Values()).To(BeEmpty())
+			Expect(f.V()).To(Equal(wyvern.Zeros[float64](3, 0)))
+			Expect(f.PseudoInverse()).To(Equal(wyvern.Zeros[float64](3, 0)))
+
+			full, e := wyvern.Zeros[float64](0, 3).FullSVD()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(full.V()).To(Equal(wyvern.Identity[float64](3)))
+
+			norm, e := wyvern.Matrix[float64]{}.Norm2()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(norm).To(Equal(0.0))
+
+			qr := wyvern.Zeros[float64](3, 0).QR()
+			Expect(qr.Q()).To(Equal(wyvern.Identity[float64](3)))
+			Expect(qr.R()).To(Equal(wyvern.Zeros[float64](3, 0)))
+			Expect(wyvern.Zeros[float64](3, 0).GramSchmidt().Q()).To(Equal(wyvern.Zeros[float64](3, 0)))
+		})
+
+		It("Solves for no right-hand sides", func() {
+			a := must(wyvern.FromRows([]wyvern.Vector[float64]{{1, 0, 2}, {0, 1, 3}}))
+			x, e := a.SolveMany(wyvern.Zeros[float64](2, 0))
+			Expect(e).To(MatchError(wyvern.ErrUnderdetermined))
+			Expect(x).To(Equal(wyvern.Zeros[float64](3, 0)))
 		})
 	})
 })
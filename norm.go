@@ -0,0 +1,149 @@
+package wyvern
+
+import (
+	"math"
+)
+
+// Norm returns the p-norm of the Vector: the p-th root of the sum of the p-th
+// powers of the absolute values of its components.  p may be 1, 2, any greater
+// value, or math.Inf(1) for the largest absolute value.  Norm returns NaN if p is
+// less than 1, for which the formula does not define a norm, or if any component
+// is NaN.  The norm of an empty Vector is zero.
+//
+// Unlike Magnitude, Norm returns an N.  The 2-norm and general p-norms are
+// accumulated relative to the largest component, so they neither overflow nor
+// underflow unless the result itself does.
+func (v Vector[N]) Norm(p float64) N {
+	switch {
+	case p == 1:
+		var sum N
+		for _, val := range v {
+			sum += abs(val)
+		}
+		return sum
+
+	case p == 2:
+		var scale, ssq float64
+		for _, val := range v {
+			scale, ssq = addSquare(scale, ssq, float64(val))
+		}
+		return N(scale * math.Sqrt(ssq))
+
+	case math.IsInf(p, 1):
+		return v.maxAbs()
+
+	case p > 1:
+		scale := float64(v.maxAbs())
+		if scale == 0 || math.IsInf(scale, 1) || math.IsNaN(scale) {
+			return N(scale)
+		}
+
+		var sum float64
+		for _, val := range v {
+			sum += math.Pow(math.Abs(float64(val))/scale, p)
+		}
+		return N(scale * math.Pow(sum, 1/p))
+	}
+
+	return N(math.NaN())
+}
+
+// maxAbs returns the largest absolute value of any component of the Vector, or
+// NaN if any component is NaN.
+func (v Vector[N]) maxAbs() N {
+	var m N
+	for _, val := range v {
+		if val != val {
+			return val
+		}
+		m = max(m, abs(val))
+	}
+
+	return m
+}
+
+// addSquare adds x² to the sum of squares represented as scale² * ssq, where
+// scale is the largest absolute value seen so far, and returns the new scale and
+// ssq.  Keeping the terms relative to scale avoids overflow and underflow.  This
+// is the accumulation used by the LAPACK routine dnrm2.
+func addSquare(scale, ssq, x float64) (float64, float64) {
+	if x == 0 {
+		return scale, ssq
+	}
+
+	switch ax := math.Abs(x); {
+	case ax == scale:
+		// Handled separately so that repeated infinities do not give
+		// Inf/Inf.
+		ssq++
+	case ax > scale:
+		ssq = 1 + ssq*(scale/ax)*(scale/ax)
+		scale = ax
+	default:
+		// This includes a NaN x, which makes ssq NaN.
+		ssq += (ax / scale) * (ax / scale)
+	}
+
+	return scale, ssq
+}
+
+// FrobeniusNorm returns the Frobenius norm of the Matrix: the square root of the
+// sum of the squares of its entries, accumulated as for Vector.Norm(2).
+func (a Matrix[N]) FrobeniusNorm() N {
+	var scale, ssq float64
+	for _, c := range a.columnViews() {
+		for _, val := range c {
+			scale, ssq = addSquare(scale, ssq, float64(val))
+		}
+	}
+
+	return N(scale * math.Sqrt(ssq))
+}
+
+// Norm1 returns the 1-norm of the Matrix, the norm induced by the Vector 1-norm:
+// the largest sum of the absolute values of the entries in any column.
+func (a Matrix[N]) Norm1() N {
+	var m N
+	for _, c := range a.columnViews() {
+		sum := c.Norm(1)
+		if sum != sum {
+			return sum
+		}
+		m = max(m, sum)
+	}
+
+	return m
+}
+
+// NormInf returns the infinity-norm of the Matrix, the norm induced by the
+// Vector infinity-norm: the largest sum of the absolute values of the entries in
+// any row.
+func (a Matrix[N]) NormInf() N {
+	sums := make(Vector[N], a.rows)
+	for _, c := range a.columnViews() {
+		for ri, val := range c {
+			sums[ri] += abs(val)
+		}
+	}
+
+	return sums.maxAbs()
+}
+
+// MaxAbs returns the largest absolute value of any entry in the Matrix, or NaN if
+// any entry is NaN.  This is a norm, but unlike the others it is not induced by a
+// Vector norm.
+//
+// The spectral norm, induced by the Vector 2-norm, is the largest singular value
+// and is returned by Norm2.
+func (a Matrix[N]) MaxAbs() N {
+	var m N
+	for _, c := range a.columnViews() {
+		cm := c.maxAbs()
+		if cm != cm {
+			return cm
+		}
+		m = max(m, cm)
+	}
+
+	return m
+}
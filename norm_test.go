@@ -0,0 +1,108 @@
+package wyvern_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+var _ = Describe("Norms", func() {
+	Describe("Vector.Norm", func() {
+		v := wyvern.Vector[float64]{3, -4, 0, 12}
+
+		It("Computes the common norms", func() {
+			Expect(v.Norm(1)).To(Equal(19.0))
+			Expect(v.Norm(2)).To(Equal(13.0))
+			Expect(v.Norm(math.Inf(1))).To(Equal(12.0))
+			Expect(v.Norm(3)).To(BeNumerically("~", math.Cbrt(27+64+1728), 1e-12))
+		})
+
+		It("Agrees with Magnitude", func() {
+			w := wyvern.Vector[float64]{0.5, 1.5, -2.25, 7}
+			Expect(float64(w.Norm(2))).To(BeNumerically("~", w.Magnitude(), 1e-15))
+		})
+
+		It("Returns N", func() {
+			var n float32 = wyvern.Vector[float32]{3, 4}.Norm(2)
+			Expect(n).To(Equal(float32(5)))
+		})
+
+		It("Does not overflow or underflow", func() {
+			big := wyvern.Vector[float64]{3e200, 4e200}
+			Expect(big.Norm(2)).To(BeNumerically("~", 5e200, 1e188))
+			Expect(big.Norm(4)).To(BeNumerically("~", math.Pow(81+256, 0.25)*1e200, 1e188))
+
+			small := wyvern.Vector[float64]{3e-200, 4e-200}
+			Expect(small.Norm(2)).To(BeNumerically("~", 5e-200, 1e-212))
+
+			single := wyvern.Vector[float32]{3e30, 4e30}
+			Expect(single.Norm(2)).To(BeNumerically("~", 5e30, 1e24))
+		})
+
+		It("Handles special values", func() {
+			Expect(wyvern.Vector[float64]{}.Norm(2)).To(BeZero())
+			Expect(wyvern.Vector[float64]{0, 0}.Norm(3)).To(BeZero())
+			Expect(wyvern.Vector[float64]{math.Inf(-1), math.Inf(1)}.Norm(2)).To(Equal(math.Inf(1)))
+			Expect(math.IsNaN(wyvern.Vector[float64]{1, math.NaN()}.Norm(2))).To(BeTrue())
+			Expect(math.IsNaN(wyvern.Vector[float64]{math.NaN(), 1}.Norm(math.Inf(1)))).To(BeTrue())
+			Expect(math.IsNaN(v.Norm(0.5))).To(BeTrue())
+		})
+	})
+
+	Describe("Matrix norms", func() {
+		var a wyvern.Matrix[float64]
+
+		BeforeEach(func() {
+			a = must(wyvern.FromRows([]wyvern.Vector[float64]{
+				{1, -2, 3},
+				{-4, 5, -6},
+			}))
+		})
+
+		It("Computes the Frobenius norm", func() {
+			Expect(a.FrobeniusNorm()).To(BeNumerically("~", math.Sqrt(91), 1e-12))
+		})
+
+		It("Computes the induced 1- and infinity-norms", func() {
+			Expect(a.Norm1()).To(Equal(9.0))
+			Expect(a.NormInf()).To(Equal(15.0))
+			Expect(a.Transpose().Norm1()).To(Equal(a.NormInf()))
+		})
+
+		It("Computes the max-abs norm", func() {
+			Expect(a.MaxAbs()).To(Equal(6.0))
+		})
+
+		It("Bounds the spectral norm", func() {
+			norm2, e := a.Norm2()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(norm2).To(BeNumerically("<=", a.FrobeniusNorm()))
+			Expect(norm2 * norm2).To(BeNumerically("<=", a.Norm1()*a.NormInf()*(1+1e-12)))
+		})
+
+		It("Does not overflow", func() {
+			big := a.Scale(1e300)
+			Expect(big.FrobeniusNorm()).To(BeNumerically("~", math.Sqrt(91)*1e300, 1e288))
+		})
+
+		It("Returns zero for an empty Matrix", func() {
+			for _, m := range []wyvern.Matrix[float64]{{}, wyvern.Zeros[float64](0, 3), wyvern.Zeros[float64](3, 0)} {
+				Expect(m.FrobeniusNorm()).To(BeZero())
+				Expect(m.Norm1()).To(BeZero())
+				Expect(m.NormInf()).To(BeZero())
+				Expect(m.MaxAbs()).To(BeZero())
+			}
+		})
+
+		It("Propagates NaN", func() {
+			Expect(a.Set(1, 1, math.NaN())).To(Succeed())
+			Expect(math.IsNaN(a.FrobeniusNorm())).To(BeTrue())
+			Expect(math.IsNaN(a.Norm1())).To(BeTrue())
+			Expect(math.IsNaN(a.NormInf())).To(BeTrue())
+			Expect(math.IsNaN(a.MaxAbs())).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package wyvern
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/ScarletTanager/wyvern/internal/numeric"
+)
+
+// epsilon returns the machine epsilon for N - the difference between 1 and the
+// next representable value.
+func epsilon[N constraints.Float]() N {
+	return numeric.Epsilon[N]()
+}
+
+func abs[N constraints.Float](x N) N {
+	return numeric.Abs(x)
+}
+
+func sqrt[N constraints.Float](x N) N {
+	return N(math.Sqrt(float64(x)))
+}
+
+// hypot returns sqrt(a² + b²), avoiding overflow and underflow.
+func hypot[N constraints.Float](a, b N) N {
+	return N(math.Hypot(float64(a), float64(b)))
+}
+
+// pivotTolerance returns the magnitude below which a candidate pivot is treated
+// as zero during elimination.  If any entry is NaN the Matrix has no meaningful
+// scale, so the tolerance is zero and only exact zeros are rejected as pivots.
+func (a Matrix[N]) pivotTolerance() N {
+	rows, cols := a.Dims()
+	m := a.MaxAbs()
+	if m != m {
+		return 0
+	}
+
+	return N(max(rows, cols)) * m * epsilon[N]()
+}
@@ -0,0 +1,228 @@
+package wyvern
+
+import (
+	"runtime"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+const (
+	defaultProductBlockSize = 64
+	defaultProductThreshold = 64
+)
+
+// ProductOptions controls how ProductWith computes a matrix product.  The zero
+// value of each field selects its default.
+type ProductOptions struct {
+	// Workers is the number of goroutines sharing the work.  The default is
+	// runtime.GOMAXPROCS(0).
+	Workers int
+
+	// BlockSize is the edge length of the square blocks into which the
+	// operands are tiled, chosen so that a block of each operand fits in
+	// cache together.  The default is 64.
+	BlockSize int
+
+	// Threshold is the dimension below which the product is computed
+	// directly, on the calling goroutine, since blocking and goroutines cost
+	// more than they save for small matrices.  The simple kernel is used when
+	// every dimension of the product is less than Threshold.  The default is
+	// 64.
+	Threshold int
+}
+
+// resolve returns a copy of o with defaults filled in.
+func (o ProductOptions) resolve() ProductOptions {
+	if o.Workers <= 0 {
+		o.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	if o.BlockSize <= 0 {
+		o.BlockSize = defaultProductBlockSize
+	}
+
+	if o.Threshold <= 0 {
+		o.Threshold = defaultProductThreshold
+	}
+
+	return o
+}
+
+// ProductWith multiplies two matrices, as Product does, using the given options.
+// Every entry of the product is accumulated in the same order whatever the
+// options, so the result does not depend on them.  The kernels round each
+// product before adding it, so the compiler never fuses a multiply and add
+// (as it may on arm64, ppc64le and s390x) in one kernel but not another.
+func (a Matrix[N]) ProductWith(b Matrix[N], opts ProductOptions) (Matrix[N], error) {
+	if !canBeMultiplied(a, b) {
+		_, aCols := a.Dims()
+		bRows, bCols := b.Dims()
+		return Matrix[N]{}, &DimensionError{
+			Op:       "Product",
+			Expected: Shape{Rows: aCols, Columns: bCols},
+			Actual:   Shape{Rows: bRows, Columns: bCols},
+		}
+	}
+
+	opts = opts.resolve()
+	rows, inner := a.Dims()
+	_, cols := b.Dims()
+
+	result := Zeros[N](rows, cols)
+	if max(rows, inner, cols) < opts.Threshold {
+		productKernel(a, b, result, 0, cols)
+		return result, nil
+	}
+
+	// Each worker takes whole panels of BlockSize columns of the product, so
+	// no two workers ever write to the same column.
+	panels := (cols + opts.BlockSize - 1) / opts.BlockSize
+	next := make(chan int, panels)
+	for p := 0; p < panels; p++ {
+		next <- p * opts.BlockSize
+	}
+	close(next)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(opts.Workers, panels); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c0 := range next {
+				blockedKernel(a, b, result, c0, min(c0+opts.BlockSize, cols), opts.BlockSize)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return result, nil
+}
+
+// productKernel accumulates columns [c0, c1) of the product ab into result, each
+// as a linear combination of the columns of a.
+func productKernel[N constraints.Float](a, b, result Matrix[N], c0, c1 int) {
+	for ci := c0; ci < c1; ci++ {
+		col := result.col(ci)
+		for k, factor := range b.col(ci) {
+			for compIdx, val := range a.col(k) {
+				col[compIdx] += N(factor * val)
+			}
+		}
+	}
+}
+
+// blockedKernel accumulates columns [c0, c1) of the product ab into result as
+// productKernel does, but tiles the rows of a and the inner dimension so that
+// the block of a being read and the block of result being written stay in
+// cache while the panel of b is swept.
+func blockedKernel[N constraints.Float](a, b, result Matrix[N], c0, c1, blockSize int) {
+	rows, inner := a.Dims()
+	for k0 := 0; k0 < inner; k0 += blockSize {
+		k1 := min(k0+blockSize, inner)
+		for r0 := 0; r0 < rows; r0 += blockSize {
+			r1 := min(r0+blockSize, rows)
+			for ci := c0; ci < c1; ci++ {
+				col := result.col(ci)[r0:r1]
+				bColumn := b.col(ci)
+
+				// Fold in four columns of a per pass over col, keeping
+				// the left to right order of the additions.
+				k := k0
+				for ; k+4 <= k1; k += 4 {
+					f0, f1, f2, f3 := bColumn[k], bColumn[k+1], bColumn[k+2], bColumn[k+3]
+					a0 := a.col(k)[r0:r1]
+					a1 := a.col(k + 1)[r0:r1]
+					a2 := a.col(k + 2)[r0:r1]
+					a3 := a.col(k + 3)[r0:r1]
+					// Reslicing to len(col) lets the compiler drop
+					// the bounds checks in the loop below.
+					a0, a1, a2, a3 = a0[:len(col)], a1[:len(col)], a2[:len(col)], a3[:len(col)]
+					for compIdx := range col {
+						col[compIdx] = col[compIdx] + N(f0*a0[compIdx]) + N(f1*a1[compIdx]) + N(f2*a2[compIdx]) + N(f3*a3[compIdx])
+					}
+				}
+				for ; k < k1; k++ {
+					factor := bColumn[k]
+					for compIdx, val := range a.col(k)[r0:r1] {
+						col[compIdx] += N(factor * val)
+					}
+				}
+			}
+		}
+	}
+}
+
+// MulVec returns the product Av, where A is the Matrix, as a new Vector.  Av is
+// computed as a linear combination of the columns of A, weighted by the
+// components of v.  A *DimensionError is returned if v does not have one
+// component per column of A.
+func (a Matrix[N]) MulVec(v Vector[N]) (Vector[N], error) {
+	if len(v) != a.cols {
+		return nil, a.vectorDimensionError("MulVec", a.cols, v)
+	}
+
+	result := make(Vector[N], a.rows)
+	a.mulVecTo(result, v)
+	return result, nil
+}
+
+// MulVecTo computes the product Av, where A is the Matrix, into dst, which is
+// overwritten.  It does not allocate, so it suits repeated products in a loop.
+// dst must not share storage with v.  A *DimensionError is returned if v does
+// not have one component per column of A, or dst one per row.
+func (a Matrix[N]) MulVecTo(dst, v Vector[N]) error {
+	if len(v) != a.cols {
+		return a.vectorDimensionError("MulVecTo", a.cols, v)
+	}
+
+	if len(dst) != a.rows {
+		return a.vectorDimensionError("MulVecTo", a.rows, dst)
+	}
+
+	a.mulVecTo(dst, v)
+	return nil
+}
+
+// VecMul returns the product v'A, where A is the Matrix, as a new Vector.
+// Component j is the dot product of v with column j of A.  A *DimensionError is
+// returned if v does not have one component per row of A.
+func (a Matrix[N]) VecMul(v Vector[N]) (Vector[N], error) {
+	if len(v) != a.rows {
+		return nil, a.vectorDimensionError("VecMul", a.rows, v)
+	}
+
+	result := make(Vector[N], a.cols)
+	for ci := range result {
+		result[ci] = v.dot(a.col(ci))
+	}
+
+	return result, nil
+}
+
+// mulVecTo overwrites dst with Av.  dst must have one component per row of a,
+// and v one per column.  Each product is rounded before it is added, as in the
+// Product kernels, so the result matches Product exactly on every platform.
+func (a Matrix[N]) mulVecTo(dst, v Vector[N]) {
+	for ri := range dst {
+		dst[ri] = 0
+	}
+
+	for ci, factor := range v {
+		col := a.col(ci)
+		d := dst[:len(col)]
+		for ri, val := range col {
+			d[ri] += N(factor * val)
+		}
+	}
+}
+
+// vectorDimensionError returns a *DimensionError for a Vector operand which
+// should have had n components.
+func (a Matrix[N]) vectorDimensionError(op string, n int, v Vector[N]) error {
+	return &DimensionError{
+		Op:       op,
+		Expected: Shape{Rows: n, Columns: 1},
+		Actual:   Shape{Rows: len(v), Columns: 1},
+	}
+}
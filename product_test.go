@@ -0,0 +1,159 @@
+package wyvern_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// simple forces the unblocked, single goroutine kernel.
+var simple = wyvern.ProductOptions{Workers: 1, Threshold: 1 << 30}
+
+var _ = Describe("ProductWith", func() {
+	var (
+		a, b wyvern.Matrix[float64]
+	)
+
+	BeforeEach(func() {
+		rng := rand.New(rand.NewSource(17))
+		a = wyvern.Random[float64](37, 29, rng)
+		b = wyvern.Random[float64](29, 41, rng)
+	})
+
+	It("Gives exactly the same result whatever the options", func() {
+		expected, e := a.ProductWith(b, simple)
+		Expect(e).NotTo(HaveOccurred())
+
+		for _, opts := range []wyvern.ProductOptions{
+			{},
+			{Workers: 1, BlockSize: 8, Threshold: 1},
+			{Workers: 3, BlockSize: 7, Threshold: 1},
+			{Workers: 64, BlockSize: 1, Threshold: 1},
+			{BlockSize: 100, Threshold: 1},
+		} {
+			p, e := a.ProductWith(b, opts)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(p).To(Equal(expected), "options %+v", opts)
+		}
+	})
+
+	It("Agrees with Product", func() {
+		p, _ := a.ProductWith(b, wyvern.ProductOptions{Workers: 2, BlockSize: 16, Threshold: 1})
+		Expect(p).To(Equal(must(a.Product(b))))
+	})
+
+	When("The dimensions are incompatible", func() {
+		It("Returns a DimensionError", func() {
+			_, e := a.ProductWith(a, wyvern.ProductOptions{Threshold: 1})
+			Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+		})
+	})
+})
+
+var _ = Describe("MulVec", func() {
+	var a wyvern.Matrix[float64]
+
+	BeforeEach(func() {
+		a = must(wyvern.FromRows([]wyvern.Vector[float64]{
+			{1, 2, 3},
+			{4, 5, 6},
+		}))
+	})
+
+	It("Multiplies a Vector on the right", func() {
+		Expect(a.MulVec(wyvern.Vector[float64]{1, 0, -1})).To(Equal(wyvern.Vector[float64]{-2, -2}))
+	})
+
+	It("Multiplies a Vector on the left", func() {
+		Expect(a.VecMul(wyvern.Vector[float64]{1, -1})).To(Equal(wyvern.Vector[float64]{-3, -3, -3}))
+	})
+
+	It("Agrees with Product", func() {
+		rng := rand.New(rand.NewSource(5))
+		m := wyvern.Random[float64](13, 9, rng)
+		v := wyvern.Random[float64](9, 1, rng)
+		x, _ := v.Column(0)
+		expected, _ := must(m.Product(v)).Column(0)
+
+		// Both accumulate the columns of m in the same order, so they agree
+		// exactly.
+		Expect(m.MulVec(x)).To(Equal(expected))
+	})
+
+	It("Writes into dst without allocating", func() {
+		dst := wyvern.Vector[float64]{100, 100}
+		v := wyvern.Vector[float64]{1, 1, 1}
+		Expect(a.MulVecTo(dst, v)).To(Succeed())
+		Expect(dst).To(Equal(wyvern.Vector[float64]{6, 15}))
+
+		allocs := testing.AllocsPerRun(10, func() {
+			a.MulVecTo(dst, v)
+		})
+		Expect(allocs).To(BeZero())
+	})
+
+	It("Works on a Slice view", func() {
+		view := must(a.Slice(0, 2, 1, 3))
+		Expect(view.MulVec(wyvern.Vector[float64]{1, 1})).To(Equal(wyvern.Vector[float64]{5, 11}))
+		Expect(view.VecMul(wyvern.Vector[float64]{1, 1})).To(Equal(wyvern.Vector[float64]{7, 9}))
+	})
+
+	When("The dimensions are incompatible", func() {
+		It("Returns a DimensionError", func() {
+			_, e := a.MulVec(wyvern.Vector[float64]{1, 2})
+			Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+
+			_, e = a.VecMul(wyvern.Vector[float64]{1, 2, 3})
+			Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+
+			e = a.MulVecTo(make(wyvern.Vector[float64], 3), wyvern.Vector[float64]{1, 2, 3})
+			var de *wyvern.DimensionError
+			Expect(errors.As(e, &de)).To(BeTrue())
+			Expect(de.Op).To(Equal("MulVecTo"))
+			Expect(de.Expected).To(Equal(wyvern.Shape{Rows: 2, Columns: 1}))
+		})
+	})
+})
+
+func benchmarkProduct(bm *testing.B, opts wyvern.ProductOptions) {
+	for _, n := range []int{64, 256, 512} {
+		rng := rand.New(rand.NewSource(1))
+		a := wyvern.Random[float64](n, n, rng)
+		b := wyvern.Random[float64](n, n, rng)
+
+		bm.Run(fmt.Sprintf("%dx%d", n, n), func(bm *testing.B) {
+			for i := 0; i < bm.N; i++ {
+				a.ProductWith(b, opts)
+			}
+		})
+	}
+}
+
+func BenchmarkProductSimple(bm *testing.B) {
+	benchmarkProduct(bm, simple)
+}
+
+func BenchmarkProductBlocked(bm *testing.B) {
+	benchmarkProduct(bm, wyvern.ProductOptions{Workers: 1})
+}
+
+func BenchmarkProductParallel(bm *testing.B) {
+	benchmarkProduct(bm, wyvern.ProductOptions{})
+}
+
+func BenchmarkMulVecTo(bm *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	a := wyvern.Random[float64](512, 512, rng)
+	v, _ := wyvern.Random[float64](512, 1, rng).Column(0)
+	dst := make(wyvern.Vector[float64], 512)
+
+	for i := 0; i < bm.N; i++ {
+		a.MulVecTo(dst, v)
+	}
+}
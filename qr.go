@@ -0,0 +1,192 @@
+package wyvern
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// QR is the QR decomposition of a Matrix A: A = QR, where the columns of Q are
+// orthonormal and R is upper triangular.
+type QR[N constraints.Float] struct {
+	q Matrix[N]
+	r Matrix[N]
+}
+
+// QR computes the QR decomposition of the Matrix using Householder reflections.
+// For an m x n Matrix, Q is an m x m orthogonal matrix and R is m x n.  This is
+// the numerically stable choice and should be preferred unless an orthonormal
+// basis for the column space is specifically needed (see GramSchmidt).
+func (a Matrix[N]) QR() *QR[N] {
+	rows, cols := a.Dims()
+	rm := a.Clone()
+	r := rm.columnViews()
+
+	reflectors := make([]Vector[N], 0, min(rows, cols))
+	for k := 0; k < cols && k < rows-1; k++ {
+		// Build v so that the reflection I - 2vv'/v'v maps the part of
+		// column k on and below the diagonal onto a multiple of e_k.
+		v := make(Vector[N], rows)
+		copy(v[k:], r[k][k:])
+
+		alpha := N(v.Magnitude())
+		if alpha == 0 {
+			continue
+		}
+		// Reflect away from x_k to avoid cancellation when forming v.
+		if v[k] > 0 {
+			alpha = -alpha
+		}
+		v[k] -= alpha
+
+		for j := k + 1; j < cols; j++ {
+			reflect(v, r[j])
+		}
+
+		r[k][k] = alpha
+		for ri := k + 1; ri < rows; ri++ {
+			r[k][ri] = 0
+		}
+
+		reflectors = append(reflectors, v)
+	}
+
+	// Q = H_0 H_1 ... H_p, so each column of the identity has the
+	// reflections applied in reverse order.
+	q := Identity[N](rows)
+	for _, c := range q.columnViews() {
+		for i := len(reflectors) - 1; i >= 0; i-- {
+			reflect(reflectors[i], c)
+		}
+	}
+
+	return &QR[N]{q: q, r: rm}
+}
+
+// GramSchmidt computes the QR decomposition of the Matrix using the modified
+// Gram-Schmidt process.  For an m x n Matrix, Q is m x n and R is n x n.  The
+// nonzero columns of Q form an orthonormal basis for the column space of the
+// Matrix; a column of the Matrix which depends on the columns before it yields
+// a zero column in Q and a zero on the diagonal of R.
+func (a Matrix[N]) GramSchmidt() *QR[N] {
+	rows, cols := a.Dims()
+	qm := a.Clone()
+	q := qm.columnViews()
+
+	r := make([]Vector[N], cols)
+	for ci := range r {
+		r[ci] = make(Vector[N], cols)
+	}
+
+	for k := 0; k < cols; k++ {
+		norm := N(q[k].Magnitude())
+		tol := N(max(rows, cols)) * epsilon[N]() * N(a.col(k).Magnitude())
+		if norm <= tol {
+			for ri := range q[k] {
+				q[k][ri] = 0
+			}
+			continue
+		}
+
+		r[k][k] = norm
+		q[k].Multiply(1 / norm)
+
+		// Remove the new direction from every remaining column now
+		// (rather than from the originals later), which is what keeps
+		// the modified process stable.
+		for j := k + 1; j < cols; j++ {
+			rkj := q[k].dot(q[j])
+			r[j][k] = rkj
+			for ri := range q[j] {
+				q[j][ri] -= rkj * q[k][ri]
+			}
+		}
+	}
+
+	return &QR[N]{q: qm, r: fromColumns(r)}
+}
+
+// OrthonormalBasis returns an orthonormal basis for the column space of the
+// Matrix, computed with the modified Gram-Schmidt process.
+func (a Matrix[N]) OrthonormalBasis() []Vector[N] {
+	f := a.GramSchmidt()
+
+	basis := make([]Vector[N], 0, f.q.cols)
+	for k, c := range f.q.columnViews() {
+		if f.r.col(k)[k] != 0 {
+			basis = append(basis, c)
+		}
+	}
+
+	return basis
+}
+
+// LeastSquares returns the x which minimizes the Euclidean norm of Ax - b, where
+// A is the Matrix, using a Householder QR decomposition.  See QR.LeastSquares.
+func (a Matrix[N]) LeastSquares(b Vector[N]) (Vector[N], error) {
+	return a.QR().LeastSquares(b)
+}
+
+// Q returns the factor with orthonormal columns.
+func (f *QR[N]) Q() Matrix[N] {
+	return f.q.Clone()
+}
+
+// R returns the upper triangular factor.
+func (f *QR[N]) R() Matrix[N] {
+	return f.r.Clone()
+}
+
+// LeastSquares returns the x which minimizes the Euclidean norm of Ax - b, where
+// A is the factored Matrix.  If Ax = b has an exact solution, that solution is
+// returned.  ErrRankDeficient is returned if the columns of A are not linearly
+// independent, and a *DimensionError if b does not have one component per row
+// of A.
+func (f *QR[N]) LeastSquares(b Vector[N]) (Vector[N], error) {
+	rows, _ := f.q.Dims()
+	_, cols := f.r.Dims()
+	if len(b) != rows {
+		return nil, &DimensionError{
+			Op:       "LeastSquares",
+			Expected: Shape{Rows: rows, Columns: 1},
+			Actual:   Shape{Rows: len(b), Columns: 1},
+		}
+	}
+
+	if rows < cols {
+		return nil, ErrRankDeficient
+	}
+
+	var largest N
+	for k := 0; k < cols; k++ {
+		largest = max(largest, abs(f.r.col(k)[k]))
+	}
+	tol := N(max(rows, cols)) * epsilon[N]() * largest
+	for k := 0; k < cols; k++ {
+		if abs(f.r.col(k)[k]) <= tol {
+			return nil, ErrRankDeficient
+		}
+	}
+
+	// x solves Rx = Q'b, restricted to the first n rows.
+	x := make(Vector[N], cols)
+	for k := range x {
+		x[k] = f.q.col(k).dot(b)
+	}
+
+	for k := cols - 1; k >= 0; k-- {
+		c := f.r.col(k)
+		x[k] /= c[k]
+		for ri := 0; ri < k; ri++ {
+			x[ri] -= c[ri] * x[k]
+		}
+	}
+
+	return x, nil
+}
+
+// reflect applies the Householder reflection I - 2vv'/v'v to x, in place.
+func reflect[N constraints.Float](v, x Vector[N]) {
+	f := 2 * v.dot(x) / v.dot(v)
+	for i := range x {
+		x[i] -= f * v[i]
+	}
+}
@@ -0,0 +1,157 @@
+package wyvern_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// expectOrthonormalColumns asserts that the columns of q are mutually orthogonal
+// unit vectors.
+func expectOrthonormalColumns(q wyvern.Matrix[float64]) {
+	cols := q.Columns()
+	for i := range cols {
+		for j := range cols {
+			expected := 0.0
+			if i == j {
+				expected = 1.0
+			}
+			dp, e := cols[i].DotProduct(cols[j])
+			ExpectWithOffset(1, e).NotTo(HaveOccurred())
+			ExpectWithOffset(1, dp).To(BeNumerically("~", expected, 1e-12))
+		}
+	}
+}
+
+// expectUpperTriangular asserts that every entry of r below the diagonal is zero.
+func expectUpperTriangular(r wyvern.Matrix[float64]) {
+	for ci, c := range r.Columns() {
+		for ri := ci + 1; ri < len(c); ri++ {
+			ExpectWithOffset(1, c[ri]).To(BeNumerically("~", 0, 1e-12))
+		}
+	}
+}
+
+var _ = Describe("QR", func() {
+	var (
+		mt   wyvern.Matrix[float64]
+		rows []wyvern.Vector[float64]
+	)
+
+	BeforeEach(func() {
+		rows = []wyvern.Vector[float64]{
+			{12, -51, 4},
+			{6, 167, -68},
+			{-4, 24, -41},
+			{1, 2, 3},
+		}
+	})
+
+	JustBeforeEach(func() {
+		mt, _ = wyvern.FromRows(rows)
+	})
+
+	Describe("Householder", func() {
+		It("Returns an orthogonal Q and upper triangular R whose product is the matrix", func() {
+			f := mt.QR()
+			Expect(f.Q().Columns()).To(HaveLen(4))
+			Expect(f.R().Columns()).To(HaveLen(3))
+			expectOrthonormalColumns(f.Q())
+			expectUpperTriangular(f.R())
+
+			product, _ := f.Q().Product(f.R())
+			expectVectorsNear(product.Rows(), rows, 1e-10)
+		})
+
+		It("Leaves the original matrix unchanged", func() {
+			mt.QR()
+			Expect(mt.Rows()).To(Equal(rows))
+		})
+	})
+
+	Describe("GramSchmidt", func() {
+		It("Returns a Q with orthonormal columns and upper triangular R whose product is the matrix", func() {
+			f := mt.GramSchmidt()
+			Expect(f.Q().Columns()).To(HaveLen(3))
+			Expect(f.R().Columns()).To(HaveLen(3))
+			expectOrthonormalColumns(f.Q())
+			expectUpperTriangular(f.R())
+
+			product, _ := f.Q().Product(f.R())
+			expectVectorsNear(product.Rows(), rows, 1e-10)
+		})
+	})
+
+	Describe("OrthonormalBasis", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 2, 0},
+				{1, 2, 0},
+				{0, 0, 3},
+			}
+		})
+
+		It("Returns an orthonormal basis for the column space", func() {
+			basis := mt.OrthonormalBasis()
+			s := 1 / 1.4142135623730951
+			expectVectorsNear(basis, []wyvern.Vector[float64]{
+				{s, s, 0},
+				{0, 0, 1},
+			}, 1e-12)
+		})
+	})
+
+	Describe("LeastSquares", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 0},
+				{1, 1},
+				{1, 2},
+			}
+		})
+
+		It("Returns the best fit solution", func() {
+			x, e := mt.LeastSquares(wyvern.Vector[float64]{6, 0, 0})
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(x, wyvern.Vector[float64]{5, -3}, 1e-12)
+		})
+
+		It("Returns the exact solution when there is one", func() {
+			x, e := mt.LeastSquares(wyvern.Vector[float64]{1, 3, 5})
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(x, wyvern.Vector[float64]{1, 2}, 1e-12)
+		})
+
+		It("Gives the same result from a Gram-Schmidt factorization", func() {
+			x, e := mt.GramSchmidt().LeastSquares(wyvern.Vector[float64]{6, 0, 0})
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(x, wyvern.Vector[float64]{5, -3}, 1e-12)
+		})
+
+		When("The columns are linearly dependent", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2},
+					{1, 2},
+					{1, 2},
+				}
+			})
+
+			It("Returns ErrRankDeficient", func() {
+				_, e := mt.LeastSquares(wyvern.Vector[float64]{6, 0, 0})
+				Expect(e).To(MatchError(wyvern.ErrRankDeficient))
+
+				_, e = mt.GramSchmidt().LeastSquares(wyvern.Vector[float64]{6, 0, 0})
+				Expect(e).To(MatchError(wyvern.ErrRankDeficient))
+			})
+		})
+
+		When("The right-hand side has the wrong dimension", func() {
+			It("Returns a DimensionError", func() {
+				_, e := mt.LeastSquares(wyvern.Vector[float64]{6, 0})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+})
@@ -0,0 +1,205 @@
+package wyvern
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Solve solves the linear system Ax = b, where A is the Matrix, using Gaussian
+// elimination with partial pivoting.  A need not be square.
+//
+// If the system has exactly one solution, it is returned with a nil error.  If
+// the system has no solution, nil and ErrInconsistent are returned.  If the
+// system has infinitely many solutions, Solve returns a particular solution (with
+// every free variable set to zero) along with an *UnderdeterminedError carrying a
+// basis for the null space of A.  A *DimensionError is returned if b does not
+// have one component per row of A.
+func (a Matrix[N]) Solve(b Vector[N]) (Vector[N], error) {
+	rows, _ := a.Dims()
+	if len(b) != rows {
+		return nil, &DimensionError{
+			Op:       "Solve",
+			Expected: Shape{Rows: rows, Columns: 1},
+			Actual:   Shape{Rows: len(b), Columns: 1},
+		}
+	}
+
+	x, err := a.solve([]Vector[N]{b})
+	if x == nil {
+		return nil, err
+	}
+
+	return x[0], err
+}
+
+// SolveMany solves AX = B, where A is the Matrix, for each column of B in turn.
+// The columns of the returned Matrix are the solutions for the corresponding
+// columns of B.  Errors are reported as for Solve: the system is inconsistent if
+// any column of B has no solution, and underdetermined if A has a nontrivial null
+// space (in which case each returned column is a particular solution).
+func (a Matrix[N]) SolveMany(b Matrix[N]) (Matrix[N], error) {
+	rows, cols := a.Dims()
+	bRows, bCols := b.Dims()
+	if bRows != rows {
+		return Matrix[N]{}, &DimensionError{
+			Op:       "SolveMany",
+			Expected: Shape{Rows: rows, Columns: bCols},
+			Actual:   Shape{Rows: bRows, Columns: bCols},
+		}
+	}
+
+	x, err := a.solve(b.columnViews())
+	if x == nil {
+		return Matrix[N]{}, err
+	}
+
+	solutions := newMatrix[N](cols, bCols)
+	for ci, c := range x {
+		copy(solutions.col(ci), c)
+	}
+
+	return solutions, err
+}
+
+// NullSpace returns a basis for the null space of the Matrix - the set of
+// vectors x for which Ax = 0.  The basis is empty if the columns of the Matrix
+// are linearly independent.
+func (a Matrix[N]) NullSpace() []Vector[N] {
+	rref, pivots := a.ReducedRowEchelon()
+	return rref.nullSpace(pivots)
+}
+
+// solve reduces the augmented matrix [A | rhs...] and reads off a solution for
+// each right-hand side.  The caller is responsible for checking dimensions.
+//
+// The rows and then the columns of A are first scaled by powers of two so that
+// the largest entry of each lies in [0.5, 1).  The scaling is exact, and it
+// makes the rank and consistency decisions relative to each row and column, so
+// a badly scaled but nonsingular A is not mistaken for a singular one.
+func (a Matrix[N]) solve(rhs []Vector[N]) ([]Vector[N], error) {
+	rows, cols := a.Dims()
+
+	rowScales := make([]N, rows)
+	for ri := range rowScales {
+		var m N
+		for _, c := range a.columnViews() {
+			m = max(m, abs(c[ri]))
+		}
+		rowScales[ri] = scaleToUnit(m)
+	}
+
+	augmented := newMatrix[N](rows, cols+len(rhs))
+	colScales := make([]N, cols)
+	for ci, c := range a.columnViews() {
+		ac := augmented.col(ci)
+		for ri, val := range c {
+			ac[ri] = val * rowScales[ri]
+		}
+		colScales[ci] = scaleToUnit(ac.maxAbs())
+		ac.Multiply(colScales[ci])
+	}
+	for si, b := range rhs {
+		ac := augmented.col(cols + si)
+		for ri, val := range b {
+			ac[ri] = val * rowScales[ri]
+		}
+	}
+
+	coefficients, _ := augmented.Slice(0, rows, 0, cols)
+	consistencyTol := augmented.pivotTolerance()
+
+	pivots := augmented.forwardEliminate(cols, coefficients.pivotTolerance())
+
+	// Any nonzero right-hand side entry in a row with no pivot means
+	// the system has no solution.
+	for si := range rhs {
+		for _, val := range augmented.col(cols + si)[len(pivots):] {
+			if abs(val) > consistencyTol {
+				return nil, ErrInconsistent
+			}
+		}
+	}
+
+	augmented.backEliminate(pivots)
+
+	// The reduction solved for the scaled unknowns x[ci] / colScales[ci].
+	solutions := make([]Vector[N], len(rhs))
+	for si := range solutions {
+		x := make(Vector[N], cols)
+		for pr, pc := range pivots {
+			x[pc] = augmented.col(cols + si)[pr] * colScales[pc]
+		}
+		solutions[si] = x
+	}
+
+	if len(pivots) < cols {
+		// The leading columns of the augmented matrix hold the reduced A.
+		basis := coefficients.nullSpace(pivots)
+
+		// Undo the column scaling.  There is one basis vector per free
+		// column, in order, and its free variable is kept at 1.
+		isPivot := make([]bool, cols)
+		for _, pc := range pivots {
+			isPivot[pc] = true
+		}
+		v := basis
+		for fc := 0; fc < cols; fc++ {
+			if isPivot[fc] {
+				continue
+			}
+			for ci := range v[0] {
+				v[0][ci] *= colScales[ci] / colScales[fc]
+			}
+			v = v[1:]
+		}
+
+		return solutions, &UnderdeterminedError[N]{NullSpace: basis}
+	}
+
+	return solutions, nil
+}
+
+// scaleToUnit returns the power of two which brings the magnitude m into
+// [0.5, 1), or 1 if m is zero, not finite, or too small for the scale to be
+// represented in N.
+func scaleToUnit[N constraints.Float](m N) N {
+	if m == 0 || m != m || math.IsInf(float64(m), 0) {
+		return 1
+	}
+
+	_, exp := math.Frexp(float64(m))
+	s := N(math.Ldexp(1, -exp))
+	if math.IsInf(float64(s), 0) {
+		return 1
+	}
+
+	return s
+}
+
+// nullSpace reads a null space basis from a Matrix in reduced row echelon form
+// with the given pivot columns.  There is one basis vector per free column.
+func (a Matrix[N]) nullSpace(pivots []int) []Vector[N] {
+	_, cols := a.Dims()
+
+	isPivot := make([]bool, cols)
+	for _, pc := range pivots {
+		isPivot[pc] = true
+	}
+
+	basis := make([]Vector[N], 0, cols-len(pivots))
+	for fc := 0; fc < cols; fc++ {
+		if isPivot[fc] {
+			continue
+		}
+
+		v := make(Vector[N], cols)
+		v[fc] = 1
+		for pr, pc := range pivots {
+			v[pc] = -a.col(fc)[pr]
+		}
+		basis = append(basis, v)
+	}
+
+	return basis
+}
@@ -0,0 +1,243 @@
+package wyvern_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+var _ = Describe("Linear systems", func() {
+	var (
+		mt   wyvern.Matrix[float64]
+		rows []wyvern.Vector[float64]
+		b    wyvern.Vector[float64]
+	)
+
+	JustBeforeEach(func() {
+		mt, _ = wyvern.FromRows(rows)
+	})
+
+	Describe("Solve", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{2, 1, -1},
+				{-3, -1, 2},
+				{-2, 1, 2},
+			}
+			b = wyvern.Vector[float64]{8, -11, -3}
+		})
+
+		When("The system has a unique solution", func() {
+			It("Returns the solution", func() {
+				x, e := mt.Solve(b)
+				Expect(e).NotTo(HaveOccurred())
+				expectVectorNear(x, wyvern.Vector[float64]{2, 3, -1}, 1e-12)
+			})
+
+			It("Leaves the matrix and the right-hand side unchanged", func() {
+				mt.Solve(b)
+				Expect(mt.Rows()).To(Equal(rows))
+				Expect(b).To(Equal(wyvern.Vector[float64]{8, -11, -3}))
+			})
+		})
+
+		When("The system is inconsistent", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 1},
+					{2, 2},
+				}
+				b = wyvern.Vector[float64]{1, 3}
+			})
+
+			It("Returns nil and ErrInconsistent", func() {
+				x, e := mt.Solve(b)
+				Expect(x).To(BeNil())
+				Expect(e).To(MatchError(wyvern.ErrInconsistent))
+			})
+		})
+
+		When("The system is underdetermined", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2, -1},
+					{2, 1, 1},
+					{1, -1, 2},
+				}
+				b = wyvern.Vector[float64]{2, 1, -1}
+			})
+
+			It("Returns a particular solution and the null space", func() {
+				x, e := mt.Solve(b)
+				Expect(e).To(MatchError(wyvern.ErrUnderdetermined))
+				expectVectorNear(x, wyvern.Vector[float64]{0, 1, 0}, 1e-12)
+
+				var ue *wyvern.UnderdeterminedError[float64]
+				Expect(errors.As(e, &ue)).To(BeTrue())
+				expectVectorsNear(ue.NullSpace, []wyvern.Vector[float64]{
+					{-1, 1, 1},
+				}, 1e-12)
+			})
+		})
+
+		When("The system is overdetermined but consistent", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 0},
+					{0, 1},
+					{1, 1},
+				}
+				b = wyvern.Vector[float64]{1, 2, 3}
+			})
+
+			It("Returns the solution", func() {
+				x, e := mt.Solve(b)
+				Expect(e).NotTo(HaveOccurred())
+				expectVectorNear(x, wyvern.Vector[float64]{1, 2}, 1e-12)
+			})
+
+			When("The extra equations contradict the others", func() {
+				BeforeEach(func() {
+					b = wyvern.Vector[float64]{1, 2, 4}
+				})
+
+				It("Returns ErrInconsistent", func() {
+					_, e := mt.Solve(b)
+					Expect(e).To(MatchError(wyvern.ErrInconsistent))
+				})
+			})
+		})
+
+		When("The matrix is badly scaled but nonsingular", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1e-20, 0},
+					{0, 1},
+				}
+				b = wyvern.Vector[float64]{1, 1}
+			})
+
+			It("Returns the solution", func() {
+				x, e := mt.Solve(b)
+				Expect(e).NotTo(HaveOccurred())
+				Expect(x).To(Equal(wyvern.Vector[float64]{1e20, 1}))
+			})
+
+			It("Is not misled by a row which is small throughout", func() {
+				mt, _ = wyvern.FromRows([]wyvern.Vector[float64]{
+					{1e-20, 1e-20},
+					{1, 2},
+				})
+				x, e := mt.Solve(wyvern.Vector[float64]{2e-20, 3})
+				Expect(e).NotTo(HaveOccurred())
+				expectVectorNear(x, wyvern.Vector[float64]{1, 1}, 1e-12)
+			})
+		})
+
+		When("The matrix is badly scaled and singular", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1e-20, 2},
+					{2e-20, 4},
+				}
+				b = wyvern.Vector[float64]{1e-20, 2e-20}
+			})
+
+			It("Returns the null space in the original scaling", func() {
+				x, e := mt.Solve(b)
+				Expect(e).To(MatchError(wyvern.ErrUnderdetermined))
+				expectVectorNear(x, wyvern.Vector[float64]{1, 0}, 1e-12)
+
+				var ue *wyvern.UnderdeterminedError[float64]
+				Expect(errors.As(e, &ue)).To(BeTrue())
+				expectVectorsNear(ue.NullSpace, []wyvern.Vector[float64]{
+					{-2e20, 1},
+				}, 1e-12)
+			})
+		})
+
+		When("The right-hand side has the wrong dimension", func() {
+			BeforeEach(func() {
+				b = wyvern.Vector[float64]{1, 2}
+			})
+
+			It("Returns a DimensionError", func() {
+				x, e := mt.Solve(b)
+				Expect(x).To(BeNil())
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("SolveMany", func() {
+		var (
+			rhs wyvern.Matrix[float64]
+		)
+
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{2, 1},
+				{1, 3},
+			}
+			rhs, _ = wyvern.FromColumns([]wyvern.Vector[float64]{
+				{3, 4},
+				{5, 5},
+			})
+		})
+
+		It("Solves the system for each column of the right-hand side", func() {
+			x, e := mt.SolveMany(rhs)
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorsNear(x.Columns(), []wyvern.Vector[float64]{
+				{1, 1},
+				{2, 1},
+			}, 1e-12)
+		})
+
+		When("The right-hand side has the wrong number of rows", func() {
+			BeforeEach(func() {
+				rhs, _ = wyvern.FromColumns([]wyvern.Vector[float64]{
+					{3, 4, 5},
+				})
+			})
+
+			It("Returns a DimensionError", func() {
+				_, e := mt.SolveMany(rhs)
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("NullSpace", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 2, 3, 4},
+				{2, 4, 7, 9},
+			}
+		})
+
+		It("Returns a basis for the null space", func() {
+			basis := mt.NullSpace()
+			expectVectorsNear(basis, []wyvern.Vector[float64]{
+				{-2, 1, 0, 0},
+				{-1, 0, -1, 1},
+			}, 1e-12)
+		})
+
+		When("The columns are linearly independent", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{2, 1},
+					{1, 3},
+				}
+			})
+
+			It("Returns an empty basis", func() {
+				Expect(mt.NullSpace()).To(BeEmpty())
+			})
+		})
+	})
+})
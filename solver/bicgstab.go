@@ -0,0 +1,100 @@
+package solver
+
+import (
+	"golang.org/x/exp/constraints"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// BiCGSTAB solves Ax = b, for general square A, by the (right preconditioned)
+// biconjugate gradient stabilized method.  ErrBreakdown is returned if the
+// iteration cannot continue.  If the solve does not converge within the
+// iteration limit, the Result is returned along with wyvern.ErrNoConvergence.
+func BiCGSTAB[N constraints.Float](a LinearOperator[N], b wyvern.Vector[N], s *Settings[N]) (*Result[N], error) {
+	settings, x, r, target, err := start(a, b, s, "BiCGSTAB")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result[N]{X: x, Residuals: []N{norm(r)}}
+	if result.Residual() <= target {
+		return result, nil
+	}
+
+	var (
+		rHat    = append(wyvern.Vector[N]{}, r...)
+		p       = make(wyvern.Vector[N], len(b))
+		v       = make(wyvern.Vector[N], len(b))
+		rho   N = 1
+		alpha N = 1
+		omega N = 1
+	)
+
+	for result.Iterations < settings.MaxIterations {
+		rhoNext := dot(rHat, r)
+		if rhoNext == 0 {
+			return result, ErrBreakdown
+		}
+
+		beta := (rhoNext / rho) * (alpha / omega)
+		for i := range p {
+			p[i] = r[i] + beta*(p[i]-omega*v[i])
+		}
+		rho = rhoNext
+
+		pHat, err := settings.Preconditioner.Apply(p)
+		if err != nil {
+			return result, err
+		}
+
+		if v, err = mulVec(a, pHat, "BiCGSTAB"); err != nil {
+			return result, err
+		}
+
+		rHatV := dot(rHat, v)
+		if rHatV == 0 {
+			return result, ErrBreakdown
+		}
+		alpha = rho / rHatV
+
+		// r now becomes the intermediate residual, conventionally s.
+		axpy(x, alpha, pHat)
+		axpy(r, -alpha, v)
+
+		result.Iterations++
+		if norm(r) <= target {
+			result.Residuals = append(result.Residuals, norm(r))
+			return result, nil
+		}
+
+		sHat, err := settings.Preconditioner.Apply(r)
+		if err != nil {
+			return result, err
+		}
+
+		t, err := mulVec(a, sHat, "BiCGSTAB")
+		if err != nil {
+			return result, err
+		}
+
+		tt := dot(t, t)
+		if tt == 0 {
+			return result, ErrBreakdown
+		}
+		omega = dot(t, r) / tt
+
+		axpy(x, omega, sHat)
+		axpy(r, -omega, t)
+
+		result.Residuals = append(result.Residuals, norm(r))
+		if result.Residual() <= target {
+			return result, nil
+		}
+
+		if omega == 0 {
+			return result, ErrBreakdown
+		}
+	}
+
+	return result, wyvern.ErrNoConvergence
+}
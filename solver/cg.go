@@ -0,0 +1,67 @@
+package solver
+
+import (
+	"golang.org/x/exp/constraints"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// CG solves Ax = b by the (preconditioned) conjugate gradient method.  A must be
+// symmetric positive definite, as must the preconditioner if one is given;
+// wyvern.ErrNotPositiveDefinite is returned if the iteration finds that A is not.
+// If the solve does not converge within the iteration limit, the Result is
+// returned along with wyvern.ErrNoConvergence.
+func CG[N constraints.Float](a LinearOperator[N], b wyvern.Vector[N], s *Settings[N]) (*Result[N], error) {
+	settings, x, r, target, err := start(a, b, s, "CG")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result[N]{X: x, Residuals: []N{norm(r)}}
+	if result.Residual() <= target {
+		return result, nil
+	}
+
+	z, err := settings.Preconditioner.Apply(r)
+	if err != nil {
+		return result, err
+	}
+
+	p := append(wyvern.Vector[N]{}, z...)
+	rz := dot(r, z)
+
+	for result.Iterations < settings.MaxIterations {
+		q, err := mulVec(a, p, "CG")
+		if err != nil {
+			return result, err
+		}
+
+		pq := dot(p, q)
+		if pq <= 0 {
+			return result, wyvern.ErrNotPositiveDefinite
+		}
+
+		alpha := rz / pq
+		axpy(x, alpha, p)
+		axpy(r, -alpha, q)
+
+		result.Iterations++
+		result.Residuals = append(result.Residuals, norm(r))
+		if result.Residual() <= target {
+			return result, nil
+		}
+
+		if z, err = settings.Preconditioner.Apply(r); err != nil {
+			return result, err
+		}
+
+		rzNext := dot(r, z)
+		beta := rzNext / rz
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+		rz = rzNext
+	}
+
+	return result, wyvern.ErrNoConvergence
+}
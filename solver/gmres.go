@@ -0,0 +1,131 @@
+package solver
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// GMRES solves Ax = b, for general square A, by the (right preconditioned)
+// generalized minimal residual method, restarted every Settings.Restart
+// iterations.  Each restart cycle keeps Restart basis vectors in memory, so
+// larger values converge more reliably at a higher cost in memory and
+// orthogonalization.  ErrBreakdown is returned if the iteration cannot continue.
+// If the solve does not converge within the iteration limit, the Result is
+// returned along with wyvern.ErrNoConvergence.
+func GMRES[N constraints.Float](a LinearOperator[N], b wyvern.Vector[N], s *Settings[N]) (*Result[N], error) {
+	settings, x, r, target, err := start(a, b, s, "GMRES")
+	if err != nil {
+		return nil, err
+	}
+
+	beta := norm(r)
+	result := &Result[N]{X: x, Residuals: []N{beta}}
+	if beta <= target {
+		return result, nil
+	}
+
+	m := settings.Restart
+	for result.Iterations < settings.MaxIterations {
+		// v holds the orthonormal Arnoldi basis and z its preconditioned
+		// images; h holds the columns of the Hessenberg matrix, reduced to
+		// upper triangular form by the Givens rotations (c, sn) as they are
+		// built; g is the right hand side of the reduced least squares
+		// problem, whose last component is the residual norm.
+		v := []wyvern.Vector[N]{r.Multiply(1 / beta)}
+		z := make([]wyvern.Vector[N], 0, m)
+		h := make([][]N, 0, m)
+		c := make([]N, 0, m)
+		sn := make([]N, 0, m)
+		g := make([]N, m+1)
+		g[0] = beta
+
+		for j := 0; j < m && result.Iterations < settings.MaxIterations; j++ {
+			zj, err := settings.Preconditioner.Apply(v[j])
+			if err != nil {
+				return result, err
+			}
+			z = append(z, zj)
+
+			w, err := mulVec(a, zj, "GMRES")
+			if err != nil {
+				return result, err
+			}
+
+			// Modified Gram-Schmidt against the basis so far.
+			hj := make([]N, j+2)
+			for i := 0; i <= j; i++ {
+				hj[i] = dot(w, v[i])
+				axpy(w, -hj[i], v[i])
+			}
+			wNorm := norm(w)
+			hj[j+1] = wNorm
+
+			for i := 0; i < j; i++ {
+				hj[i], hj[i+1] = c[i]*hj[i]+sn[i]*hj[i+1], -sn[i]*hj[i]+c[i]*hj[i+1]
+			}
+
+			cj, sj := givens(hj[j], hj[j+1])
+			c, sn = append(c, cj), append(sn, sj)
+			g[j], g[j+1] = cj*g[j], -sj*g[j]
+			hj[j], hj[j+1] = cj*hj[j]+sj*hj[j+1], 0
+			h = append(h, hj)
+
+			result.Iterations++
+			result.Residuals = append(result.Residuals, abs(g[j+1]))
+
+			if abs(g[j+1]) <= target || wNorm == 0 {
+				// Converged, or the Krylov space is invariant under
+				// A and the solution lies within it.
+				break
+			}
+			v = append(v, w.Multiply(1/wNorm))
+		}
+
+		// Solve the triangular system for the basis coefficients, then
+		// update x.
+		k := len(h)
+		y := make([]N, k)
+		for i := k - 1; i >= 0; i-- {
+			y[i] = g[i]
+			for l := i + 1; l < k; l++ {
+				y[i] -= h[l][i] * y[l]
+			}
+			if h[i][i] == 0 {
+				// A maps part of the Krylov space to zero, so the
+				// least squares problem has no unique solution.
+				return result, ErrBreakdown
+			}
+			y[i] /= h[i][i]
+		}
+
+		for i := range y {
+			axpy(x, y[i], z[i])
+		}
+
+		if r, err = residual(a, b, x, "GMRES"); err != nil {
+			return result, err
+		}
+
+		beta = norm(r)
+		result.Residuals[len(result.Residuals)-1] = beta
+		if beta <= target {
+			return result, nil
+		}
+	}
+
+	return result, wyvern.ErrNoConvergence
+}
+
+// givens returns the cosine and sine of the plane rotation which zeroes the
+// second component of (a, b).
+func givens[N constraints.Float](a, b N) (c, s N) {
+	if b == 0 {
+		return 1, 0
+	}
+
+	r := N(math.Hypot(float64(a), float64(b)))
+	return a / r, b / r
+}
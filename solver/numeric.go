@@ -0,0 +1,44 @@
+package solver
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/ScarletTanager/wyvern"
+	"github.com/ScarletTanager/wyvern/internal/numeric"
+)
+
+// epsilon returns the machine epsilon for N.
+func epsilon[N constraints.Float]() N {
+	return numeric.Epsilon[N]()
+}
+
+func abs[N constraints.Float](x N) N {
+	return numeric.Abs(x)
+}
+
+func sqrt[N constraints.Float](x N) N {
+	return N(math.Sqrt(float64(x)))
+}
+
+func norm[N constraints.Float](v wyvern.Vector[N]) N {
+	return N(v.Magnitude())
+}
+
+// dot returns the dot product of x and y, which must have the same dimension.
+func dot[N constraints.Float](x, y wyvern.Vector[N]) N {
+	var sum N
+	for i, val := range x {
+		sum += val * y[i]
+	}
+
+	return sum
+}
+
+// axpy adds alpha times x to y, in place.
+func axpy[N constraints.Float](y wyvern.Vector[N], alpha N, x wyvern.Vector[N]) {
+	for i, val := range x {
+		y[i] += alpha * val
+	}
+}
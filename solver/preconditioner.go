@@ -0,0 +1,175 @@
+package solver
+
+import (
+	"golang.org/x/exp/constraints"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// A Preconditioner approximates the coefficient matrix A by a matrix M for which
+// systems are cheap to solve.  Apply returns the solution z of Mz = r, leaving r
+// unchanged.
+type Preconditioner[N constraints.Float] interface {
+	Apply(r wyvern.Vector[N]) (wyvern.Vector[N], error)
+}
+
+// identity is the Preconditioner used when none is given.
+type identity[N constraints.Float] struct{}
+
+func (identity[N]) Apply(r wyvern.Vector[N]) (wyvern.Vector[N], error) {
+	return append(wyvern.Vector[N]{}, r...), nil
+}
+
+// Jacobi is the diagonal (Jacobi) Preconditioner: M is the diagonal of A.
+type Jacobi[N constraints.Float] struct {
+	inverse wyvern.Vector[N]
+}
+
+// NewJacobi returns the Jacobi Preconditioner for a matrix with the given
+// diagonal.  wyvern.ErrSingular is returned if any diagonal entry is zero.
+func NewJacobi[N constraints.Float](diagonal wyvern.Vector[N]) (*Jacobi[N], error) {
+	p := &Jacobi[N]{inverse: make(wyvern.Vector[N], len(diagonal))}
+	for i, d := range diagonal {
+		if d == 0 {
+			return nil, wyvern.ErrSingular
+		}
+		p.inverse[i] = 1 / d
+	}
+
+	return p, nil
+}
+
+// JacobiFromDense returns the Jacobi Preconditioner for the Matrix a.
+// wyvern.ErrNotSquare is returned if a is not square, and wyvern.ErrSingular if
+// any diagonal entry is zero.
+func JacobiFromDense[N constraints.Float](a wyvern.Matrix[N]) (*Jacobi[N], error) {
+	if !a.IsSquare() {
+		return nil, wyvern.ErrNotSquare
+	}
+
+	diagonal := make(wyvern.Vector[N], a.RowCount())
+	for i := range diagonal {
+		// i is in range, so At cannot fail.
+		diagonal[i], _ = a.At(i, i)
+	}
+
+	return NewJacobi(diagonal)
+}
+
+// JacobiFromSparse returns the Jacobi Preconditioner for the SparseMatrix a.
+// wyvern.ErrNotSquare is returned if a is not square, and wyvern.ErrSingular if
+// any diagonal entry is zero.
+func JacobiFromSparse[N constraints.Float](a wyvern.SparseMatrix[N]) (*Jacobi[N], error) {
+	rows, cols := a.Dims()
+	if rows != cols {
+		return nil, wyvern.ErrNotSquare
+	}
+
+	diagonal := make(wyvern.Vector[N], rows)
+	for i := range diagonal {
+		// i is in range, so At cannot fail.
+		diagonal[i], _ = a.At(i, i)
+	}
+
+	return NewJacobi(diagonal)
+}
+
+func (p *Jacobi[N]) Apply(r wyvern.Vector[N]) (wyvern.Vector[N], error) {
+	if len(r) != len(p.inverse) {
+		return nil, dimensionError("Jacobi.Apply", len(p.inverse), len(r))
+	}
+
+	z := make(wyvern.Vector[N], len(r))
+	for i, val := range r {
+		z[i] = val * p.inverse[i]
+	}
+
+	return z, nil
+}
+
+// ILU0 is the incomplete LU Preconditioner with zero fill-in: M = LU, where L is
+// unit lower triangular, U is upper triangular, and both have nonzero entries
+// only where A does.  The factors are stored together in compressed sparse row
+// form, L below the diagonal and U on and above it.
+type ILU0[N constraints.Float] struct {
+	rowPtr []int
+	colIdx []int
+	values []N
+	diag   []int
+}
+
+// NewILU0 computes the ILU(0) Preconditioner for the SparseMatrix a.
+// wyvern.ErrNotSquare is returned if a is not square, and wyvern.ErrSingular if
+// a zero pivot is encountered - in particular if a has no stored entry somewhere
+// on its diagonal.
+func NewILU0[N constraints.Float](a wyvern.SparseMatrix[N]) (*ILU0[N], error) {
+	n, cols := a.Dims()
+	if n != cols {
+		return nil, wyvern.ErrNotSquare
+	}
+
+	rowPtr, colIdx, values := a.CSR()
+	p := &ILU0[N]{rowPtr: rowPtr, colIdx: colIdx, values: values, diag: make([]int, n)}
+
+	// position maps each column to its index in values for the current row,
+	// or -1 if the row has no entry in that column.
+	position := make([]int, n)
+	for i := range position {
+		position[i] = -1
+	}
+
+	for i := 0; i < n; i++ {
+		lo, hi := rowPtr[i], rowPtr[i+1]
+		for k := lo; k < hi; k++ {
+			position[colIdx[k]] = k
+		}
+
+		// Eliminate the entries left of the diagonal, using the rows above,
+		// discarding any update which would fall outside the pattern of A.
+		k := lo
+		for ; k < hi && colIdx[k] < i; k++ {
+			pivotRow := colIdx[k]
+			values[k] /= values[p.diag[pivotRow]]
+			for kk := p.diag[pivotRow] + 1; kk < rowPtr[pivotRow+1]; kk++ {
+				if dest := position[colIdx[kk]]; dest >= 0 {
+					values[dest] -= values[k] * values[kk]
+				}
+			}
+		}
+
+		if k == hi || colIdx[k] != i || values[k] == 0 {
+			return nil, wyvern.ErrSingular
+		}
+		p.diag[i] = k
+
+		for k := lo; k < hi; k++ {
+			position[colIdx[k]] = -1
+		}
+	}
+
+	return p, nil
+}
+
+func (p *ILU0[N]) Apply(r wyvern.Vector[N]) (wyvern.Vector[N], error) {
+	n := len(p.diag)
+	if len(r) != n {
+		return nil, dimensionError("ILU0.Apply", n, len(r))
+	}
+
+	// Forward substitution with L, then back substitution with U.
+	z := append(wyvern.Vector[N]{}, r...)
+	for i := 0; i < n; i++ {
+		for k := p.rowPtr[i]; k < p.diag[i]; k++ {
+			z[i] -= p.values[k] * z[p.colIdx[k]]
+		}
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for k := p.diag[i] + 1; k < p.rowPtr[i+1]; k++ {
+			z[i] -= p.values[k] * z[p.colIdx[k]]
+		}
+		z[i] /= p.values[p.diag[i]]
+	}
+
+	return z, nil
+}
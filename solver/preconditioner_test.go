@@ -0,0 +1,79 @@
+package solver_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+	"github.com/ScarletTanager/wyvern/solver"
+)
+
+var _ = Describe("Preconditioners", func() {
+	Describe("Jacobi", func() {
+		It("Divides by the diagonal", func() {
+			p, e := solver.JacobiFromSparse(tridiagonal(3, -1, 2, -1))
+			Expect(e).NotTo(HaveOccurred())
+			Expect(p.Apply(wyvern.Vector[float64]{2, 4, 6})).To(Equal(wyvern.Vector[float64]{1, 2, 3}))
+		})
+
+		It("Agrees for dense and sparse matrices", func() {
+			a := tridiagonal(4, 1, 5, 2)
+			sp, _ := solver.JacobiFromSparse(a)
+			dp, _ := solver.JacobiFromDense(a.Dense())
+			Expect(dp).To(Equal(sp))
+		})
+
+		When("A diagonal entry is zero", func() {
+			It("Returns ErrSingular", func() {
+				_, e := solver.NewJacobi(wyvern.Vector[float64]{1, 0})
+				Expect(e).To(MatchError(wyvern.ErrSingular))
+			})
+		})
+
+		When("The matrix is not square", func() {
+			It("Returns ErrNotSquare", func() {
+				_, e := solver.JacobiFromDense(wyvern.Zeros[float64](2, 3))
+				Expect(e).To(MatchError(wyvern.ErrNotSquare))
+			})
+		})
+	})
+
+	Describe("ILU0", func() {
+		It("Is an exact solve for a tridiagonal matrix, which has no fill-in", func() {
+			a := tridiagonal(6, -1.5, 4, -0.5)
+			p, e := solver.NewILU0(a)
+			Expect(e).NotTo(HaveOccurred())
+
+			x := wyvern.Vector[float64]{1, -2, 3, -4, 5, -6}
+			b, _ := a.MulVec(x)
+			z, e := p.Apply(b)
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(z, x, 1e-12)
+		})
+
+		It("Leaves the argument unchanged", func() {
+			p, _ := solver.NewILU0(tridiagonal(3, -1, 2, -1))
+			r := wyvern.Vector[float64]{1, 2, 3}
+			p.Apply(r)
+			Expect(r).To(Equal(wyvern.Vector[float64]{1, 2, 3}))
+		})
+
+		When("The diagonal has a missing entry", func() {
+			It("Returns ErrSingular", func() {
+				b, _ := wyvern.NewCOO[float64](2, 2)
+				b.Add(0, 1, 1)
+				b.Add(1, 0, 1)
+				_, e := solver.NewILU0(b.Sparse())
+				Expect(e).To(MatchError(wyvern.ErrSingular))
+			})
+		})
+
+		When("The argument has the wrong dimension", func() {
+			It("Returns a DimensionError", func() {
+				p, _ := solver.NewILU0(tridiagonal(3, -1, 2, -1))
+				_, e := p.Apply(wyvern.Vector[float64]{1})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+})
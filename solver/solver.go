@@ -0,0 +1,157 @@
+// Package solver provides iterative methods for solving large linear systems
+// Ax = b, for which the direct factorizations in wyvern (LU, QR, Cholesky) would
+// be too expensive.  The methods only ever need to multiply a Vector by A, so A
+// can be anything implementing LinearOperator, including a wyvern.SparseMatrix
+// or a dense wyvern.Matrix.
+package solver
+
+import (
+	"errors"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// ErrBreakdown is returned when an iterative method cannot continue because a
+// quantity it must divide by has become zero.  Restarting from the returned
+// approximation, or using a different method or preconditioner, may succeed.
+var ErrBreakdown = errors.New("Iterative method broke down")
+
+// A LinearOperator represents the coefficient matrix A of a system Ax = b.
+// MulVec returns the product Av.
+type LinearOperator[N constraints.Float] interface {
+	MulVec(v wyvern.Vector[N]) (wyvern.Vector[N], error)
+}
+
+// Settings controls an iterative solve.  The zero value of each field selects a
+// default, and a nil *Settings selects all the defaults.
+type Settings[N constraints.Float] struct {
+	// Tolerance is the relative residual ||b - Ax|| / ||b|| at which the
+	// solve is considered to have converged.  The default is the square root
+	// of the machine epsilon for N.
+	Tolerance N
+
+	// MaxIterations bounds the number of iterations (for GMRES, the total
+	// number of inner iterations across all restarts).  The default is ten
+	// times the dimension of the system.
+	MaxIterations int
+
+	// Restart is the number of GMRES iterations between restarts.  It is
+	// ignored by the other methods.  The default is 30, or the dimension of
+	// the system if that is smaller.
+	Restart int
+
+	// Preconditioner, if set, is applied to accelerate convergence.
+	Preconditioner Preconditioner[N]
+
+	// InitialGuess is the starting approximation to x.  The default is the
+	// zero Vector.
+	InitialGuess wyvern.Vector[N]
+}
+
+// Result reports the outcome of an iterative solve.
+type Result[N constraints.Float] struct {
+	// X is the final approximation to the solution.
+	X wyvern.Vector[N]
+
+	// Iterations is the number of iterations performed.
+	Iterations int
+
+	// Residuals holds the residual norm ||b - Ax|| before the first iteration
+	// and after each subsequent one.  For GMRES the entries within a restart
+	// cycle are the estimates maintained by the method; the entry ending
+	// each cycle is the true residual.
+	Residuals []N
+}
+
+// Residual returns the final residual norm.
+func (r *Result[N]) Residual() N {
+	return r.Residuals[len(r.Residuals)-1]
+}
+
+// resolve returns a copy of s, which may be nil, with defaults filled in for a
+// system of dimension n.
+func (s *Settings[N]) resolve(n int) Settings[N] {
+	var r Settings[N]
+	if s != nil {
+		r = *s
+	}
+
+	if r.Tolerance <= 0 {
+		r.Tolerance = sqrt(epsilon[N]())
+	}
+
+	if r.MaxIterations <= 0 {
+		r.MaxIterations = 10 * n
+	}
+
+	if r.Restart <= 0 {
+		r.Restart = min(30, n)
+	}
+
+	if r.Preconditioner == nil {
+		r.Preconditioner = identity[N]{}
+	}
+
+	return r
+}
+
+// start validates the system and returns the resolved settings, the initial
+// approximation (a copy of the initial guess), its residual, and the residual
+// norm at which the solve has converged.
+func start[N constraints.Float](a LinearOperator[N], b wyvern.Vector[N], s *Settings[N], op string) (Settings[N], wyvern.Vector[N], wyvern.Vector[N], N, error) {
+	n := len(b)
+	settings := s.resolve(n)
+
+	x := make(wyvern.Vector[N], n)
+	if settings.InitialGuess != nil {
+		if len(settings.InitialGuess) != n {
+			return settings, nil, nil, 0, dimensionError(op, n, len(settings.InitialGuess))
+		}
+		copy(x, settings.InitialGuess)
+	}
+
+	r, err := residual(a, b, x, op)
+	if err != nil {
+		return settings, nil, nil, 0, err
+	}
+
+	return settings, x, r, settings.Tolerance * norm(b), nil
+}
+
+// residual returns b - Ax.
+func residual[N constraints.Float](a LinearOperator[N], b, x wyvern.Vector[N], op string) (wyvern.Vector[N], error) {
+	r, err := mulVec(a, x, op)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range r {
+		r[i] = b[i] - r[i]
+	}
+
+	return r, nil
+}
+
+// mulVec returns Av, checking that A is square.
+func mulVec[N constraints.Float](a LinearOperator[N], v wyvern.Vector[N], op string) (wyvern.Vector[N], error) {
+	av, err := a.MulVec(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(av) != len(v) {
+		return nil, dimensionError(op, len(v), len(av))
+	}
+
+	return av, nil
+}
+
+func dimensionError(op string, expected, actual int) error {
+	return &wyvern.DimensionError{
+		Op:       op,
+		Expected: wyvern.Shape{Rows: expected, Columns: 1},
+		Actual:   wyvern.Shape{Rows: actual, Columns: 1},
+	}
+}
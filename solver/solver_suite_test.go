@@ -0,0 +1,36 @@
+package solver_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+	"github.com/ScarletTanager/wyvern/internal/testutil"
+)
+
+func TestSolver(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Solver Suite")
+}
+
+// tridiagonal returns the n x n SparseMatrix with sub on the subdiagonal, diag on
+// the diagonal and super on the superdiagonal.
+func tridiagonal(n int, sub, diag, super float64) wyvern.SparseMatrix[float64] {
+	b, _ := wyvern.NewCOO[float64](n, n)
+	for i := 0; i < n; i++ {
+		b.Add(i, i, diag)
+		if i > 0 {
+			b.Add(i, i-1, sub)
+		}
+		if i < n-1 {
+			b.Add(i, i+1, super)
+		}
+	}
+
+	return b.Sparse()
+}
+
+// expectVectorNear is the assertion shared with the wyvern suite.
+var expectVectorNear = testutil.ExpectVectorNear
@@ -0,0 +1,144 @@
+package solver_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+	"github.com/ScarletTanager/wyvern/solver"
+)
+
+type method func(solver.LinearOperator[float64], wyvern.Vector[float64], *solver.Settings[float64]) (*solver.Result[float64], error)
+
+var _ = Describe("Iterative solvers", func() {
+	const n = 50
+
+	var (
+		a    wyvern.SparseMatrix[float64]
+		x, b wyvern.Vector[float64]
+	)
+
+	// setup makes a the given tridiagonal matrix and b the right hand side for
+	// which the solution is x = (1, 2, ..., n).
+	setup := func(sub, diag, super float64) {
+		a = tridiagonal(n, sub, diag, super)
+		x = make(wyvern.Vector[float64], n)
+		for i := range x {
+			x[i] = float64(i + 1)
+		}
+		b, _ = a.MulVec(x)
+	}
+
+	itSolves := func(solve method) {
+		It("Solves the system", func() {
+			r, e := solve(a, b, nil)
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(r.X, x, 1e-5)
+		})
+
+		It("Reports the residual history", func() {
+			r, e := solve(a, b, &solver.Settings[float64]{Tolerance: 1e-10})
+			Expect(e).NotTo(HaveOccurred())
+			Expect(r.Iterations).To(BeNumerically(">", 0))
+			Expect(r.Residuals).To(HaveLen(r.Iterations + 1))
+			Expect(r.Residuals[0]).To(BeNumerically("~", b.Magnitude(), 1e-9))
+			Expect(r.Residual()).To(BeNumerically("<=", 1e-10*b.Magnitude()))
+		})
+
+		It("Works against a dense Matrix", func() {
+			r, e := solve(a.Dense(), b, nil)
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(r.X, x, 1e-5)
+		})
+
+		It("Converges at least as quickly with an ILU(0) preconditioner", func() {
+			plain, _ := solve(a, b, nil)
+
+			ilu, e := solver.NewILU0(a)
+			Expect(e).NotTo(HaveOccurred())
+			r, e := solve(a, b, &solver.Settings[float64]{Preconditioner: ilu})
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(r.X, x, 1e-5)
+			Expect(r.Iterations).To(BeNumerically("<=", plain.Iterations))
+		})
+
+		It("Starts from the initial guess", func() {
+			r, e := solve(a, b, &solver.Settings[float64]{InitialGuess: x})
+			Expect(e).NotTo(HaveOccurred())
+			Expect(r.Iterations).To(BeZero())
+			Expect(r.X).To(Equal(x))
+		})
+
+		It("Returns the zero vector when b is zero", func() {
+			r, e := solve(a, make(wyvern.Vector[float64], n), nil)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(r.X).To(Equal(make(wyvern.Vector[float64], n)))
+		})
+
+		When("The iteration limit is reached", func() {
+			It("Returns the approximation so far with ErrNoConvergence", func() {
+				r, e := solve(a, b, &solver.Settings[float64]{MaxIterations: 2})
+				Expect(e).To(MatchError(wyvern.ErrNoConvergence))
+				Expect(r.Iterations).To(Equal(2))
+				Expect(r.Residual()).To(BeNumerically("<", r.Residuals[0]))
+			})
+		})
+
+		When("b has the wrong dimension", func() {
+			It("Returns a DimensionError", func() {
+				_, e := solve(a, b[:n-1], nil)
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	}
+
+	Describe("CG", func() {
+		BeforeEach(func() {
+			setup(-1, 2, -1)
+		})
+
+		itSolves(solver.CG[float64])
+
+		It("Converges in at most n iterations", func() {
+			r, _ := solver.CG[float64](a, b, nil)
+			Expect(r.Iterations).To(BeNumerically("<=", n))
+		})
+
+		When("The matrix is not positive definite", func() {
+			It("Returns ErrNotPositiveDefinite", func() {
+				_, e := solver.CG[float64](tridiagonal(n, -1, -2, -1), b, nil)
+				Expect(e).To(MatchError(wyvern.ErrNotPositiveDefinite))
+			})
+		})
+	})
+
+	Describe("GMRES", func() {
+		BeforeEach(func() {
+			setup(-1.5, 4, -0.5)
+		})
+
+		itSolves(solver.GMRES[float64])
+
+		It("Restarts without losing convergence", func() {
+			r, e := solver.GMRES[float64](a, b, &solver.Settings[float64]{Restart: 3})
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(r.X, x, 1e-5)
+		})
+
+		When("A maps the Krylov space to zero", func() {
+			It("Returns ErrBreakdown", func() {
+				r, e := solver.GMRES[float64](wyvern.Zeros[float64](n, n), b, nil)
+				Expect(e).To(MatchError(solver.ErrBreakdown))
+				Expect(r.X).To(Equal(make(wyvern.Vector[float64], n)))
+			})
+		})
+	})
+
+	Describe("BiCGSTAB", func() {
+		BeforeEach(func() {
+			setup(-1.5, 4, -0.5)
+		})
+
+		itSolves(solver.BiCGSTAB[float64])
+	})
+})
@@ -0,0 +1,396 @@
+package wyvern
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A SparseMatrix stores only the nonzero entries of a Matrix, in compressed
+// sparse column (CSC) form: the entries of column j are
+// values[colPtr[j]:colPtr[j+1]], lying in the rows given by the same range of
+// rowIdx.  Within each column the row indices are strictly increasing.
+//
+// Like Matrix, a SparseMatrix is column oriented, so converting between the two
+// and multiplying one by the other never requires a transpose.  Use a COO to
+// build a SparseMatrix entry by entry, or FromCSC/FromCSR to wrap existing
+// compressed storage.
+type SparseMatrix[N constraints.Float] struct {
+	rows, cols int
+	colPtr     []int
+	rowIdx     []int
+	values     []N
+}
+
+// FromCSC returns a SparseMatrix with the given compressed sparse column storage.
+// The slices are copied, so the caller remains free to modify them.  ErrMalformedSparse is returned if
+// colPtr does not have cols+1 nondecreasing entries starting at zero, if rowIdx
+// and values do not both have colPtr[cols] entries, or if the row indices within
+// a column are not strictly increasing and in range.
+func FromCSC[N constraints.Float](rows, cols int, colPtr, rowIdx []int, values []N) (SparseMatrix[N], error) {
+	if !validCompressed(rows, cols, colPtr, rowIdx, len(values)) {
+		return SparseMatrix[N]{}, ErrMalformedSparse
+	}
+
+	return SparseMatrix[N]{
+		rows:   rows,
+		cols:   cols,
+		colPtr: append([]int{}, colPtr...),
+		rowIdx: append([]int{}, rowIdx...),
+		values: append([]N{}, values...),
+	}, nil
+}
+
+// FromCSR returns a SparseMatrix holding the matrix described by the given
+// compressed sparse row storage: the entries of row i are
+// values[rowPtr[i]:rowPtr[i+1]], lying in the columns given by the same range
+// of colIdx.  The storage is converted to CSC, so the slices are not retained.
+// ErrMalformedSparse is returned under the same conditions as for FromCSC.
+func FromCSR[N constraints.Float](rows, cols int, rowPtr, colIdx []int, values []N) (SparseMatrix[N], error) {
+	if !validCompressed(cols, rows, rowPtr, colIdx, len(values)) {
+		return SparseMatrix[N]{}, ErrMalformedSparse
+	}
+
+	// CSR storage of A is CSC storage of A transposed.
+	t := SparseMatrix[N]{rows: cols, cols: rows, colPtr: rowPtr, rowIdx: colIdx, values: values}
+	return t.Transpose(), nil
+}
+
+// SparseFromDense returns a SparseMatrix holding the nonzero entries of a.
+func SparseFromDense[N constraints.Float](a Matrix[N]) SparseMatrix[N] {
+	rows, cols := a.Dims()
+	s := SparseMatrix[N]{rows: rows, cols: cols, colPtr: make([]int, cols+1)}
+	for ci, c := range a.columnViews() {
+		for ri, val := range c {
+			if val != 0 {
+				s.rowIdx = append(s.rowIdx, ri)
+				s.values = append(s.values, val)
+			}
+		}
+		s.colPtr[ci+1] = len(s.values)
+	}
+
+	return s
+}
+
+// validCompressed reports whether ptr, idx and a values slice of length nvals
+// form valid compressed storage for major lines of length minor.
+func validCompressed(minor, major int, ptr, idx []int, nvals int) bool {
+	if minor < 0 || major < 0 || len(ptr) != major+1 || ptr[0] != 0 {
+		return false
+	}
+
+	if len(idx) != ptr[major] || nvals != ptr[major] {
+		return false
+	}
+
+	// Check every pointer before scanning idx, so that a pointer beyond the
+	// end of idx is rejected rather than used.
+	for j := 0; j < major; j++ {
+		if ptr[j+1] < ptr[j] || ptr[j+1] > len(idx) {
+			return false
+		}
+	}
+
+	for j := 0; j < major; j++ {
+		for k := ptr[j]; k < ptr[j+1]; k++ {
+			if idx[k] < 0 || idx[k] >= minor || (k > ptr[j] && idx[k] <= idx[k-1]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Dims returns the number of rows and columns in the SparseMatrix.
+func (s SparseMatrix[N]) Dims() (rows, cols int) {
+	return s.rows, s.cols
+}
+
+// NNZ returns the number of stored (nonzero) entries in the SparseMatrix.
+func (s SparseMatrix[N]) NNZ() int {
+	return len(s.values)
+}
+
+// At returns the entry at the specified row and column, which is zero if no
+// entry is stored there.
+func (s SparseMatrix[N]) At(rowIndex, columnIndex int) (N, error) {
+	if rowIndex < 0 || rowIndex >= s.rows {
+		return 0, &IndexError{Op: "At", Index: rowIndex, Limit: s.rows}
+	}
+
+	if columnIndex < 0 || columnIndex >= s.cols {
+		return 0, &IndexError{Op: "At", Index: columnIndex, Limit: s.cols}
+	}
+
+	lo, hi := s.colPtr[columnIndex], s.colPtr[columnIndex+1]
+	k := lo + sort.SearchInts(s.rowIdx[lo:hi], rowIndex)
+	if k < hi && s.rowIdx[k] == rowIndex {
+		return s.values[k], nil
+	}
+
+	return 0, nil
+}
+
+// CSC returns copies of the compressed sparse column storage of the
+// SparseMatrix, as described for FromCSC.
+func (s SparseMatrix[N]) CSC() (colPtr, rowIdx []int, values []N) {
+	return append([]int{}, s.colPtr...), append([]int{}, s.rowIdx...), append([]N{}, s.values...)
+}
+
+// CSR returns the compressed sparse row storage of the SparseMatrix, as
+// described for FromCSR.
+func (s SparseMatrix[N]) CSR() (rowPtr, colIdx []int, values []N) {
+	t := s.Transpose()
+	return t.colPtr, t.rowIdx, t.values
+}
+
+// Dense returns the SparseMatrix as a (dense) Matrix.
+func (s SparseMatrix[N]) Dense() Matrix[N] {
+	m := Zeros[N](s.rows, s.cols)
+	for ci, c := range m.columnViews() {
+		for k := s.colPtr[ci]; k < s.colPtr[ci+1]; k++ {
+			c[s.rowIdx[k]] = s.values[k]
+		}
+	}
+
+	return m
+}
+
+// Transpose returns the transpose of the SparseMatrix as a new SparseMatrix.
+func (s SparseMatrix[N]) Transpose() SparseMatrix[N] {
+	t := SparseMatrix[N]{
+		rows:   s.cols,
+		cols:   s.rows,
+		colPtr: make([]int, s.rows+1),
+		rowIdx: make([]int, len(s.values)),
+		values: make([]N, len(s.values)),
+	}
+
+	// Count the entries in each row of s, which become the columns of t.
+	for _, ri := range s.rowIdx {
+		t.colPtr[ri+1]++
+	}
+	for ri := 0; ri < s.rows; ri++ {
+		t.colPtr[ri+1] += t.colPtr[ri]
+	}
+
+	// Walking s column by column fills each column of t in increasing row order.
+	next := append([]int{}, t.colPtr[:s.rows]...)
+	for ci := 0; ci < s.cols; ci++ {
+		for k := s.colPtr[ci]; k < s.colPtr[ci+1]; k++ {
+			dest := next[s.rowIdx[k]]
+			t.rowIdx[dest] = ci
+			t.values[dest] = s.values[k]
+			next[s.rowIdx[k]]++
+		}
+	}
+
+	return t
+}
+
+// MulVec returns the product Av, where A is the SparseMatrix.  A *DimensionError
+// is returned if v does not have one component per column of A.
+func (s SparseMatrix[N]) MulVec(v Vector[N]) (Vector[N], error) {
+	if len(v) != s.cols {
+		return nil, &DimensionError{
+			Op:       "MulVec",
+			Expected: Shape{Rows: s.cols, Columns: 1},
+			Actual:   Shape{Rows: len(v), Columns: 1},
+		}
+	}
+
+	result := make(Vector[N], s.rows)
+	for ci, factor := range v {
+		for k := s.colPtr[ci]; k < s.colPtr[ci+1]; k++ {
+			result[s.rowIdx[k]] += factor * s.values[k]
+		}
+	}
+
+	return result, nil
+}
+
+// Product multiplies two sparse matrices.  s is the matrix on the left, b on the
+// right.  As with Matrix.Product, each column of the product is a linear
+// combination of the columns of s, but only the columns selected by the stored
+// entries of b are visited.  A *DimensionError is returned if the number of
+// columns in s does not equal the number of rows in b.
+func (s SparseMatrix[N]) Product(b SparseMatrix[N]) (SparseMatrix[N], error) {
+	if s.cols != b.rows {
+		return SparseMatrix[N]{}, &DimensionError{
+			Op:       "Product",
+			Expected: Shape{Rows: s.cols, Columns: b.cols},
+			Actual:   Shape{Rows: b.rows, Columns: b.cols},
+		}
+	}
+
+	result := SparseMatrix[N]{rows: s.rows, cols: b.cols, colPtr: make([]int, b.cols+1)}
+
+	// acc accumulates the current column of the product; marker records the
+	// column in which each row was last touched, and pattern the rows touched
+	// in the current column.
+	acc := make([]N, s.rows)
+	marker := make([]int, s.rows)
+	for i := range marker {
+		marker[i] = -1
+	}
+	var pattern []int
+
+	for ci := 0; ci < b.cols; ci++ {
+		pattern = pattern[:0]
+		for kb := b.colPtr[ci]; kb < b.colPtr[ci+1]; kb++ {
+			k, factor := b.rowIdx[kb], b.values[kb]
+			for ks := s.colPtr[k]; ks < s.colPtr[k+1]; ks++ {
+				ri := s.rowIdx[ks]
+				if marker[ri] != ci {
+					marker[ri] = ci
+					acc[ri] = 0
+					pattern = append(pattern, ri)
+				}
+				acc[ri] += factor * s.values[ks]
+			}
+		}
+
+		sort.Ints(pattern)
+		for _, ri := range pattern {
+			if acc[ri] != 0 {
+				result.rowIdx = append(result.rowIdx, ri)
+				result.values = append(result.values, acc[ri])
+			}
+		}
+		result.colPtr[ci+1] = len(result.values)
+	}
+
+	return result, nil
+}
+
+// ProductDense multiplies the SparseMatrix s on the left by the (dense) Matrix b
+// on the right, returning a dense Matrix.  A *DimensionError is returned if the
+// number of columns in s does not equal the number of rows in b.
+func (s SparseMatrix[N]) ProductDense(b Matrix[N]) (Matrix[N], error) {
+	bRows, bCols := b.Dims()
+	if s.cols != bRows {
+		return Matrix[N]{}, &DimensionError{
+			Op:       "ProductDense",
+			Expected: Shape{Rows: s.cols, Columns: bCols},
+			Actual:   Shape{Rows: bRows, Columns: bCols},
+		}
+	}
+
+	result := newMatrix[N](s.rows, bCols)
+	for ci, bColumn := range b.columnViews() {
+		col := result.col(ci)
+		for k, factor := range bColumn {
+			for kk := s.colPtr[k]; kk < s.colPtr[k+1]; kk++ {
+				col[s.rowIdx[kk]] += factor * s.values[kk]
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// ProductSparse multiplies the (dense) Matrix a on the left by the SparseMatrix s
+// on the right, returning a dense Matrix.  A *DimensionError is returned if the
+// number of columns in a does not equal the number of rows in s.
+func (a Matrix[N]) ProductSparse(s SparseMatrix[N]) (Matrix[N], error) {
+	rows, cols := a.Dims()
+	if cols != s.rows {
+		return Matrix[N]{}, &DimensionError{
+			Op:       "ProductSparse",
+			Expected: Shape{Rows: cols, Columns: s.cols},
+			Actual:   Shape{Rows: s.rows, Columns: s.cols},
+		}
+	}
+
+	result := newMatrix[N](rows, s.cols)
+	for ci, col := range result.columnViews() {
+		for k := s.colPtr[ci]; k < s.colPtr[ci+1]; k++ {
+			factor := s.values[k]
+			for compIdx, val := range a.col(s.rowIdx[k]) {
+				col[compIdx] += factor * val
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// A COO builds a SparseMatrix from entries supplied in any order, as
+// (row, column, value) coordinates.  Entries added more than once at the same
+// position are summed.
+type COO[N constraints.Float] struct {
+	rows, cols int
+	rowIdx     []int
+	colIdx     []int
+	values     []N
+}
+
+// NewCOO returns an empty COO builder for a SparseMatrix with the specified
+// numbers of rows and columns.  A *DimensionError is returned if either is
+// negative.
+func NewCOO[N constraints.Float](rows, cols int) (*COO[N], error) {
+	if rows < 0 || cols < 0 {
+		return nil, &DimensionError{Op: "NewCOO", Expected: Shape{max(rows, 0), max(cols, 0)}, Actual: Shape{rows, cols}}
+	}
+
+	return &COO[N]{rows: rows, cols: cols}, nil
+}
+
+// Add adds val to the entry at the specified row and column.  An *IndexError is
+// returned if either index is out of range.
+func (c *COO[N]) Add(rowIndex, columnIndex int, val N) error {
+	if rowIndex < 0 || rowIndex >= c.rows {
+		return &IndexError{Op: "Add", Index: rowIndex, Limit: c.rows}
+	}
+
+	if columnIndex < 0 || columnIndex >= c.cols {
+		return &IndexError{Op: "Add", Index: columnIndex, Limit: c.cols}
+	}
+
+	c.rowIdx = append(c.rowIdx, rowIndex)
+	c.colIdx = append(c.colIdx, columnIndex)
+	c.values = append(c.values, val)
+	return nil
+}
+
+// Sparse returns the SparseMatrix holding the entries added so far.  Duplicate
+// entries are summed, and entries which sum to zero are not stored.  The COO can
+// continue to be used afterwards.
+func (c *COO[N]) Sparse() SparseMatrix[N] {
+	// Bucket the entries by column, then sort each column by row.
+	order := make([]int, len(c.values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if c.colIdx[a] != c.colIdx[b] {
+			return c.colIdx[a] < c.colIdx[b]
+		}
+		return c.rowIdx[a] < c.rowIdx[b]
+	})
+
+	s := SparseMatrix[N]{rows: c.rows, cols: c.cols, colPtr: make([]int, c.cols+1)}
+	for i := 0; i < len(order); {
+		ri, ci := c.rowIdx[order[i]], c.colIdx[order[i]]
+
+		var sum N
+		for ; i < len(order) && c.rowIdx[order[i]] == ri && c.colIdx[order[i]] == ci; i++ {
+			sum += c.values[order[i]]
+		}
+
+		if sum != 0 {
+			s.rowIdx = append(s.rowIdx, ri)
+			s.values = append(s.values, sum)
+			s.colPtr[ci+1]++
+		}
+	}
+
+	for ci := 0; ci < c.cols; ci++ {
+		s.colPtr[ci+1] += s.colPtr[ci]
+	}
+
+	return s
+}
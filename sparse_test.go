@@ -0,0 +1,221 @@
+package wyvern_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+var _ = Describe("SparseMatrix", func() {
+	var (
+		dense wyvern.Matrix[float64]
+		s     wyvern.SparseMatrix[float64]
+	)
+
+	BeforeEach(func() {
+		dense = must(wyvern.FromRows([]wyvern.Vector[float64]{
+			{4, 0, 0, 1},
+			{0, 0, 2, 0},
+			{0, 3, 0, 0},
+		}))
+		s = wyvern.SparseFromDense(dense)
+	})
+
+	Describe("SparseFromDense and Dense", func() {
+		It("Stores only the nonzero entries", func() {
+			Expect(s.NNZ()).To(Equal(4))
+			rows, cols := s.Dims()
+			Expect(rows).To(Equal(3))
+			Expect(cols).To(Equal(4))
+		})
+
+		It("Round-trips the Matrix", func() {
+			Expect(s.Dense()).To(Equal(dense))
+		})
+
+		It("Produces sorted CSC storage", func() {
+			colPtr, rowIdx, values := s.CSC()
+			Expect(colPtr).To(Equal([]int{0, 1, 2, 3, 4}))
+			Expect(rowIdx).To(Equal([]int{0, 2, 1, 0}))
+			Expect(values).To(Equal([]float64{4, 3, 2, 1}))
+		})
+	})
+
+	Describe("At", func() {
+		It("Returns stored entries and zero elsewhere", func() {
+			Expect(s.At(1, 2)).To(Equal(2.0))
+			Expect(s.At(1, 1)).To(Equal(0.0))
+		})
+
+		When("An index is out of range", func() {
+			It("Returns an IndexError", func() {
+				_, e := s.At(3, 0)
+				Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+			})
+		})
+	})
+
+	Describe("FromCSC and FromCSR", func() {
+		It("Builds the same matrix from either storage format", func() {
+			c, e := wyvern.FromCSC(3, 4, []int{0, 1, 2, 3, 4}, []int{0, 2, 1, 0}, []float64{4, 3, 2, 1})
+			Expect(e).NotTo(HaveOccurred())
+			Expect(c.Dense()).To(Equal(dense))
+
+			r, e := wyvern.FromCSR(3, 4, []int{0, 2, 3, 4}, []int{0, 3, 2, 1}, []float64{4, 1, 2, 3})
+			Expect(e).NotTo(HaveOccurred())
+			Expect(r).To(Equal(c))
+		})
+
+		It("Round-trips through CSR", func() {
+			rowPtr, colIdx, values := s.CSR()
+			Expect(rowPtr).To(Equal([]int{0, 2, 3, 4}))
+			r, e := wyvern.FromCSR(3, 4, rowPtr, colIdx, values)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(r.Dense()).To(Equal(dense))
+		})
+
+		It("Copies the storage passed to FromCSC", func() {
+			colPtr, rowIdx, values := []int{0, 1, 2}, []int{0, 1}, []float64{1, 2}
+			c, e := wyvern.FromCSC(2, 2, colPtr, rowIdx, values)
+			Expect(e).NotTo(HaveOccurred())
+
+			values[0], rowIdx[1] = 9, 0
+			Expect(c.Dense()).To(Equal(wyvern.Diagonal(wyvern.Vector[float64]{1, 2})))
+		})
+
+		When("The storage is malformed", func() {
+			It("Returns ErrMalformedSparse", func() {
+				_, e := wyvern.FromCSC(3, 2, []int{0, 1, 2}, []int{0, 3}, []float64{1, 1})
+				Expect(e).To(MatchError(wyvern.ErrMalformedSparse))
+
+				_, e = wyvern.FromCSC(3, 1, []int{0, 2}, []int{1, 0}, []float64{1, 1})
+				Expect(e).To(MatchError(wyvern.ErrMalformedSparse))
+
+				_, e = wyvern.FromCSR(3, 1, []int{0, 1}, []int{0}, []float64{1})
+				Expect(e).To(MatchError(wyvern.ErrMalformedSparse))
+			})
+
+			It("Rejects pointers beyond the end of the indices without panicking", func() {
+				_, e := wyvern.FromCSC(3, 2, []int{0, 5, 2}, []int{0, 1}, []float64{1, 2})
+				Expect(e).To(MatchError(wyvern.ErrMalformedSparse))
+
+				_, e = wyvern.FromCSR(2, 3, []int{0, 5, 2}, []int{0, 1}, []float64{1, 2})
+				Expect(e).To(MatchError(wyvern.ErrMalformedSparse))
+			})
+		})
+	})
+
+	Describe("COO", func() {
+		It("Builds a SparseMatrix from unordered entries, summing duplicates", func() {
+			b, e := wyvern.NewCOO[float64](3, 4)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(b.Add(0, 3, 1)).To(Succeed())
+			Expect(b.Add(2, 1, 3)).To(Succeed())
+			Expect(b.Add(0, 0, 1.5)).To(Succeed())
+			Expect(b.Add(1, 2, 2)).To(Succeed())
+			Expect(b.Add(0, 0, 2.5)).To(Succeed())
+			Expect(b.Sparse()).To(Equal(s))
+		})
+
+		It("Drops entries which sum to zero", func() {
+			b, _ := wyvern.NewCOO[float64](2, 2)
+			b.Add(0, 1, 2)
+			b.Add(0, 1, -2)
+			Expect(b.Sparse().NNZ()).To(BeZero())
+		})
+
+		When("An index is out of range", func() {
+			It("Returns an IndexError", func() {
+				b, _ := wyvern.NewCOO[float64](2, 2)
+				e := b.Add(0, 2, 1)
+
+				var ie *wyvern.IndexError
+				Expect(errors.As(e, &ie)).To(BeTrue())
+				Expect(ie.Limit).To(Equal(2))
+			})
+		})
+
+		When("A dimension is negative", func() {
+			It("Returns a DimensionError", func() {
+				_, e := wyvern.NewCOO[float64](-1, 2)
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+
+				var de *wyvern.DimensionError
+				Expect(errors.As(e, &de)).To(BeTrue())
+				Expect(de.Actual).To(Equal(wyvern.Shape{Rows: -1, Columns: 2}))
+			})
+		})
+	})
+
+	Describe("Transpose", func() {
+		It("Returns the transpose", func() {
+			Expect(s.Transpose().Dense()).To(Equal(dense.Transpose()))
+		})
+	})
+
+	Describe("MulVec", func() {
+		It("Returns the matrix-vector product", func() {
+			Expect(s.MulVec(wyvern.Vector[float64]{1, 2, 3, 4})).To(Equal(wyvern.Vector[float64]{8, 6, 6}))
+		})
+
+		When("The vector has the wrong dimension", func() {
+			It("Returns a DimensionError", func() {
+				_, e := s.MulVec(wyvern.Vector[float64]{1, 2, 3})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Products", func() {
+		var other wyvern.Matrix[float64]
+
+		BeforeEach(func() {
+			other = must(wyvern.FromRows([]wyvern.Vector[float64]{
+				{1, 0, 2},
+				{0, -1, 0},
+				{0, 0, 5},
+				{-4, 0, 0},
+			}))
+		})
+
+		It("Multiplies two sparse matrices", func() {
+			p, e := s.Product(wyvern.SparseFromDense(other))
+			Expect(e).NotTo(HaveOccurred())
+			Expect(p.Dense()).To(Equal(must(dense.Product(other))))
+		})
+
+		It("Does not store entries which cancel", func() {
+			// Row 0 of s times column 0 of other is 4*1 + 1*(-4) = 0.
+			p, _ := s.Product(wyvern.SparseFromDense(other))
+			Expect(p.NNZ()).To(Equal(3))
+		})
+
+		It("Multiplies a sparse matrix by a dense one", func() {
+			p, e := s.ProductDense(other)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(p).To(Equal(must(dense.Product(other))))
+		})
+
+		It("Multiplies a dense matrix by a sparse one", func() {
+			p, e := other.ProductSparse(s)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(p).To(Equal(must(other.Product(dense))))
+		})
+
+		When("The dimensions are incompatible", func() {
+			It("Returns a DimensionError", func() {
+				_, e := s.Product(s)
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+
+				_, e = s.ProductDense(dense)
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+
+				_, e = dense.ProductSparse(s)
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+})
@@ -0,0 +1,714 @@
+package wyvern
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// maxSVDIterations bounds the number of QR sweeps spent isolating any single
+// singular value before giving up with ErrNoConvergence.
+const maxSVDIterations = 75
+
+// SVD is the singular value decomposition of an m x n Matrix A: A = U Σ V', where
+// the columns of U and V are orthonormal and Σ is diagonal with nonnegative
+// entries (the singular values) in descending order.  The decomposition is
+// computed in float64 precision regardless of N.
+//
+// A thin SVD, with k = min(m, n), has U of size m x k, Σ of size k x k and V of
+// size n x k.  A full SVD has U of size m x m, Σ of size m x n and V of size
+// n x n.
+type SVD[N constraints.Float] struct {
+	u      Matrix[N]
+	values Vector[N]
+	v      Matrix[N]
+	rows   int
+	cols   int
+}
+
+// SVD computes the thin singular value decomposition of the Matrix, using
+// Householder bidiagonalization followed by the Golub-Kahan implicit QR
+// iteration.  ErrNoConvergence is returned if the iteration fails to converge.
+func (a Matrix[N]) SVD() (*SVD[N], error) {
+	return a.svd(false)
+}
+
+// FullSVD computes the full singular value decomposition of the Matrix: U and V
+// are square, their extra columns completing orthonormal bases for the row and
+// column spaces.
+func (a Matrix[N]) FullSVD() (*SVD[N], error) {
+	return a.svd(true)
+}
+
+func (a Matrix[N]) svd(full bool) (*SVD[N], error) {
+	rows, cols := a.Dims()
+
+	// The algorithm requires at least as many rows as columns.  For a wide
+	// Matrix, decompose the transpose (whose rows are the columns of a) and
+	// swap the roles of U and V.
+	transposed := rows < cols
+	var data [][]float64
+	if transposed {
+		data = make([][]float64, cols)
+		for ri := range data {
+			data[ri] = make([]float64, rows)
+			for ci, val := range a.col(ri) {
+				data[ri][ci] = float64(val)
+			}
+		}
+	} else {
+		data = make([][]float64, rows)
+		for ri := range data {
+			data[ri] = make([]float64, cols)
+			for ci, c := range a.columnViews() {
+				data[ri][ci] = float64(c[ri])
+			}
+		}
+	}
+
+	u, s, v, err := golubKahan(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if transposed {
+		u, v = v, u
+	}
+
+	// Thin factors have one column per singular value, full ones are square.
+	uCols, vCols := len(s), len(s)
+	if full {
+		u = completeBasis(u, rows)
+		v = completeBasis(v, cols)
+		uCols, vCols = rows, cols
+	}
+
+	values := make(Vector[N], len(s))
+	for i, val := range s {
+		values[i] = N(val)
+	}
+
+	return &SVD[N]{
+		u:      fromFloat64Rows[N](u, uCols),
+		values: values,
+		v:      fromFloat64Rows[N](v, vCols),
+		rows:   rows,
+		cols:   cols,
+	}, nil
+}
+
+// U returns the left singular vectors as the columns of a Matrix.
+func (f *SVD[N]) U() Matrix[N] {
+	return f.u.Clone()
+}
+
+// V returns the right singular vectors as the columns of a Matrix.
+func (f *SVD[N]) V() Matrix[N] {
+	return f.v.Clone()
+}
+
+// VT returns the transpose of V, so that A is the product of U(), Sigma() and
+// VT().
+func (f *SVD[N]) VT() Matrix[N] {
+	return f.v.Transpose()
+}
+
+// Values returns the singular values, in descending order.
+func (f *SVD[N]) Values() Vector[N] {
+	return append(Vector[N]{}, f.values...)
+}
+
+// Sigma returns the diagonal matrix of singular values, sized to fit between U
+// and VT.
+func (f *SVD[N]) Sigma() Matrix[N] {
+	rows := f.u.cols
+	cols := f.v.cols
+
+	sigma := Zeros[N](rows, cols)
+	for i, val := range f.values {
+		sigma.col(i)[i] = val
+	}
+
+	return sigma
+}
+
+// Rank returns the number of singular values greater than tol.  If tol is not
+// positive, a default of max(m, n) * eps * (largest singular value) is used,
+// where eps is the machine epsilon for N.
+func (f *SVD[N]) Rank(tol N) int {
+	if tol <= 0 {
+		tol = f.defaultTolerance()
+	}
+
+	rank := 0
+	for _, val := range f.values {
+		if val > tol {
+			rank++
+		}
+	}
+
+	return rank
+}
+
+// PseudoInverse returns the Moore-Penrose pseudoinverse V Σ⁺ U' of the decomposed
+// Matrix, where Σ⁺ inverts the singular values above the default Rank tolerance
+// and zeroes the rest.
+func (f *SVD[N]) PseudoInverse() Matrix[N] {
+	tol := f.defaultTolerance()
+
+	// Column j of the pseudoinverse is the sum over k of V[:,k] U[j,k] / s_k.
+	pinv := newMatrix[N](f.cols, f.rows)
+	for j, c := range pinv.columnViews() {
+		for k, s := range f.values {
+			if s <= tol {
+				continue
+			}
+
+			factor := f.u.col(k)[j] / s
+			for i, val := range f.v.col(k) {
+				c[i] += factor * val
+			}
+		}
+	}
+
+	return pinv
+}
+
+// ConditionNumber returns the 2-norm condition number of the decomposed Matrix:
+// the ratio of its largest and smallest singular values.  It is +Inf for a
+// rank-deficient Matrix, that is one whose smallest singular value is at or
+// below the default Rank tolerance.
+func (f *SVD[N]) ConditionNumber() N {
+	if len(f.values) == 0 {
+		return 0
+	}
+
+	smallest := f.values[len(f.values)-1]
+	if smallest <= f.defaultTolerance() {
+		return N(math.Inf(1))
+	}
+
+	return f.values[0] / smallest
+}
+
+// Norm2 returns the spectral norm of the decomposed Matrix: its largest
+// singular value.
+func (f *SVD[N]) Norm2() N {
+	if len(f.values) == 0 {
+		return 0
+	}
+
+	return f.values[0]
+}
+
+func (f *SVD[N]) defaultTolerance() N {
+	return N(max(f.rows, f.cols)) * epsilon[N]() * f.Norm2()
+}
+
+// Rank returns the rank of the Matrix: the number of its singular values greater
+// than tol.  See SVD.Rank.
+func (a Matrix[N]) Rank(tol N) (int, error) {
+	f, err := a.SVD()
+	if err != nil {
+		return 0, err
+	}
+
+	return f.Rank(tol), nil
+}
+
+// PseudoInverse returns the Moore-Penrose pseudoinverse of the Matrix.  See
+// SVD.PseudoInverse.
+func (a Matrix[N]) PseudoInverse() (Matrix[N], error) {
+	f, err := a.SVD()
+	if err != nil {
+		return Matrix[N]{}, err
+	}
+
+	return f.PseudoInverse(), nil
+}
+
+// ConditionNumber returns the 2-norm condition number of the Matrix.  A large
+// condition number means that solving systems against the Matrix amplifies
+// errors in the right-hand side.  See SVD.ConditionNumber.
+func (a Matrix[N]) ConditionNumber() (N, error) {
+	f, err := a.SVD()
+	if err != nil {
+		return 0, err
+	}
+
+	return f.ConditionNumber(), nil
+}
+
+// Norm2 returns the spectral norm of the Matrix: its largest singular value.
+func (a Matrix[N]) Norm2() (N, error) {
+	f, err := a.SVD()
+	if err != nil {
+		return 0, err
+	}
+
+	return f.Norm2(), nil
+}
+
+// golubKahan computes the thin SVD of the m x n row-major matrix a, which must
+// have m >= n, returning U (m x n), the singular values and V (n x n).  It is
+// adapted from the LINPACK routine dsvdc, by way of the public domain JAMA
+// library.
+func golubKahan(a [][]float64) ([][]float64, []float64, [][]float64, error) {
+	m := len(a)
+	n := 0
+	if m > 0 {
+		n = len(a[0])
+	}
+	nu := min(m, n)
+
+	s := make([]float64, min(m+1, n))
+	u := make([][]float64, m)
+	for i := range u {
+		u[i] = make([]float64, nu)
+	}
+	v := make([][]float64, n)
+	for i := range v {
+		v[i] = make([]float64, n)
+	}
+	if n == 0 {
+		return u, s, v, nil
+	}
+
+	e := make([]float64, n)
+	work := make([]float64, m)
+
+	// Reduce a to bidiagonal form, storing the diagonal elements in s and
+	// the superdiagonal elements in e.
+	nct := min(m-1, n)
+	nrt := max(0, min(n-2, m))
+	for k := 0; k < max(nct, nrt); k++ {
+		if k < nct {
+			// Compute the transformation for the k-th column and
+			// place the k-th diagonal in s[k].
+			s[k] = 0
+			for i := k; i < m; i++ {
+				s[k] = math.Hypot(s[k], a[i][k])
+			}
+			if s[k] != 0 {
+				if a[k][k] < 0 {
+					s[k] = -s[k]
+				}
+				for i := k; i < m; i++ {
+					a[i][k] /= s[k]
+				}
+				a[k][k]++
+			}
+			s[k] = -s[k]
+		}
+
+		for j := k + 1; j < n; j++ {
+			if k < nct && s[k] != 0 {
+				var t float64
+				for i := k; i < m; i++ {
+					t += a[i][k] * a[i][j]
+				}
+				t = -t / a[k][k]
+				for i := k; i < m; i++ {
+					a[i][j] += t * a[i][k]
+				}
+			}
+
+			// Keep the k-th row for the row transformation.
+			e[j] = a[k][j]
+		}
+
+		if k < nct {
+			for i := k; i < m; i++ {
+				u[i][k] = a[i][k]
+			}
+		}
+
+		if k < nrt {
+			// Compute the k-th row transformation and place the
+			// k-th superdiagonal in e[k].
+			e[k] = 0
+			for i := k + 1; i < n; i++ {
+				e[k] = math.Hypot(e[k], e[i])
+			}
+			if e[k] != 0 {
+				if e[k+1] < 0 {
+					e[k] = -e[k]
+				}
+				for i := k + 1; i < n; i++ {
+					e[i] /= e[k]
+				}
+				e[k+1]++
+			}
+			e[k] = -e[k]
+
+			if k+1 < m && e[k] != 0 {
+				for i := k + 1; i < m; i++ {
+					work[i] = 0
+				}
+				for j := k + 1; j < n; j++ {
+					for i := k + 1; i < m; i++ {
+						work[i] += e[j] * a[i][j]
+					}
+				}
+				for j := k + 1; j < n; j++ {
+					t := -e[j] / e[k+1]
+					for i := k + 1; i < m; i++ {
+						a[i][j] += t * work[i]
+					}
+				}
+			}
+
+			for i := k + 1; i < n; i++ {
+				v[i][k] = e[i]
+			}
+		}
+	}
+
+	// Set up the final bidiagonal matrix of order p.
+	p := min(n, m+1)
+	if nct < n {
+		s[nct] = a[nct][nct]
+	}
+	if m < p {
+		s[p-1] = 0
+	}
+	if nrt+1 < p {
+		e[nrt] = a[nrt][p-1]
+	}
+	if p > 0 {
+		e[p-1] = 0
+	}
+
+	// Generate U.
+	for j := nct; j < nu; j++ {
+		for i := 0; i < m; i++ {
+			u[i][j] = 0
+		}
+		u[j][j] = 1
+	}
+	for k := nct - 1; k >= 0; k-- {
+		if s[k] != 0 {
+			for j := k + 1; j < nu; j++ {
+				var t float64
+				for i := k; i < m; i++ {
+					t += u[i][k] * u[i][j]
+				}
+				t = -t / u[k][k]
+				for i := k; i < m; i++ {
+					u[i][j] += t * u[i][k]
+				}
+			}
+			for i := k; i < m; i++ {
+				u[i][k] = -u[i][k]
+			}
+			u[k][k]++
+			for i := 0; i < k-1; i++ {
+				u[i][k] = 0
+			}
+		} else {
+			for i := 0; i < m; i++ {
+				u[i][k] = 0
+			}
+			u[k][k] = 1
+		}
+	}
+
+	// Generate V.
+	for k := n - 1; k >= 0; k-- {
+		if k < nrt && e[k] != 0 {
+			for j := k + 1; j < n; j++ {
+				var t float64
+				for i := k + 1; i < n; i++ {
+					t += v[i][k] * v[i][j]
+				}
+				t = -t / v[k+1][k]
+				for i := k + 1; i < n; i++ {
+					v[i][j] += t * v[i][k]
+				}
+			}
+		}
+		for i := 0; i < n; i++ {
+			v[i][k] = 0
+		}
+		v[k][k] = 1
+	}
+
+	// Main iteration loop for the singular values.
+	pp := p - 1
+	iter := 0
+	eps := epsilon[float64]()
+	tiny := math.Pow(2, -966)
+	for p > 0 {
+		if iter > maxSVDIterations {
+			return nil, nil, nil, ErrNoConvergence
+		}
+
+		// Inspect for negligible elements in s and e.  On completion,
+		// kase and k are set as follows:
+		//
+		// kase = 1: s[p-1] and e[k-1] are negligible and k < p
+		// kase = 2: s[k] is negligible and k < p
+		// kase = 3: e[k-1] is negligible, k < p, and s[k], ..., s[p-1]
+		//           are not negligible (perform a QR step)
+		// kase = 4: e[p-2] is negligible (convergence)
+		var k, kase int
+		for k = p - 2; k >= 0; k-- {
+			if math.Abs(e[k]) <= tiny+eps*(math.Abs(s[k])+math.Abs(s[k+1])) {
+				e[k] = 0
+				break
+			}
+		}
+
+		if k == p-2 {
+			kase = 4
+		} else {
+			var ks int
+			for ks = p - 1; ks > k; ks-- {
+				var t float64
+				if ks != p {
+					t += math.Abs(e[ks])
+				}
+				if ks != k+1 {
+					t += math.Abs(e[ks-1])
+				}
+				if math.Abs(s[ks]) <= tiny+eps*t {
+					s[ks] = 0
+					break
+				}
+			}
+
+			if ks == k {
+				kase = 3
+			} else if ks == p-1 {
+				kase = 1
+			} else {
+				kase = 2
+				k = ks
+			}
+		}
+		k++
+
+		switch kase {
+		case 1:
+			// Deflate negligible s[p-1].
+			f := e[p-2]
+			e[p-2] = 0
+			for j := p - 2; j >= k; j-- {
+				t := math.Hypot(s[j], f)
+				cs := s[j] / t
+				sn := f / t
+				s[j] = t
+				if j != k {
+					f = -sn * e[j-1]
+					e[j-1] = cs * e[j-1]
+				}
+				for i := 0; i < n; i++ {
+					t = cs*v[i][j] + sn*v[i][p-1]
+					v[i][p-1] = -sn*v[i][j] + cs*v[i][p-1]
+					v[i][j] = t
+				}
+			}
+
+		case 2:
+			// Split at negligible s[k].
+			f := e[k-1]
+			e[k-1] = 0
+			for j := k; j < p; j++ {
+				t := math.Hypot(s[j], f)
+				cs := s[j] / t
+				sn := f / t
+				s[j] = t
+				f = -sn * e[j]
+				e[j] = cs * e[j]
+				for i := 0; i < m; i++ {
+					t = cs*u[i][j] + sn*u[i][k-1]
+					u[i][k-1] = -sn*u[i][j] + cs*u[i][k-1]
+					u[i][j] = t
+				}
+			}
+
+		case 3:
+			// Perform one QR step, starting with the shift.
+			scale := math.Max(math.Max(math.Max(math.Max(
+				math.Abs(s[p-1]), math.Abs(s[p-2])), math.Abs(e[p-2])),
+				math.Abs(s[k])), math.Abs(e[k]))
+			sp := s[p-1] / scale
+			spm1 := s[p-2] / scale
+			epm1 := e[p-2] / scale
+			sk := s[k] / scale
+			ek := e[k] / scale
+			b := ((spm1+sp)*(spm1-sp) + epm1*epm1) / 2
+			c := (sp * epm1) * (sp * epm1)
+			var shift float64
+			if b != 0 || c != 0 {
+				shift = math.Sqrt(b*b + c)
+				if b < 0 {
+					shift = -shift
+				}
+				shift = c / (b + shift)
+			}
+			f := (sk+sp)*(sk-sp) + shift
+			g := sk * ek
+
+			// Chase zeros.
+			for j := k; j < p-1; j++ {
+				t := math.Hypot(f, g)
+				cs := f / t
+				sn := g / t
+				if j != k {
+					e[j-1] = t
+				}
+				f = cs*s[j] + sn*e[j]
+				e[j] = cs*e[j] - sn*s[j]
+				g = sn * s[j+1]
+				s[j+1] = cs * s[j+1]
+				for i := 0; i < n; i++ {
+					t = cs*v[i][j] + sn*v[i][j+1]
+					v[i][j+1] = -sn*v[i][j] + cs*v[i][j+1]
+					v[i][j] = t
+				}
+
+				t = math.Hypot(f, g)
+				cs = f / t
+				sn = g / t
+				s[j] = t
+				f = cs*e[j] + sn*s[j+1]
+				s[j+1] = -sn*e[j] + cs*s[j+1]
+				g = sn * e[j+1]
+				e[j+1] = cs * e[j+1]
+				if j < m-1 {
+					for i := 0; i < m; i++ {
+						t = cs*u[i][j] + sn*u[i][j+1]
+						u[i][j+1] = -sn*u[i][j] + cs*u[i][j+1]
+						u[i][j] = t
+					}
+				}
+			}
+			e[p-2] = f
+			iter++
+
+		case 4:
+			// Make the singular value positive.
+			if s[k] <= 0 {
+				if s[k] < 0 {
+					s[k] = -s[k]
+				} else {
+					s[k] = 0
+				}
+				for i := 0; i <= pp; i++ {
+					v[i][k] = -v[i][k]
+				}
+			}
+
+			// Order the singular values.
+			for k < pp && s[k] < s[k+1] {
+				s[k], s[k+1] = s[k+1], s[k]
+				if k < n-1 {
+					for i := 0; i < n; i++ {
+						v[i][k], v[i][k+1] = v[i][k+1], v[i][k]
+					}
+				}
+				if k < m-1 {
+					for i := 0; i < m; i++ {
+						u[i][k], u[i][k+1] = u[i][k+1], u[i][k]
+					}
+				}
+				k++
+			}
+			iter = 0
+			p--
+		}
+	}
+
+	return u, s[:nu], v, nil
+}
+
+// completeBasis extends the orthonormal columns of the row-major matrix q to an
+// orthonormal basis of R^dim, returning a dim x dim row-major matrix.  Each new
+// column is the standard basis vector with the largest component orthogonal to
+// the columns chosen so far, orthogonalized (twice, for stability) and
+// normalized.
+func completeBasis(q [][]float64, dim int) [][]float64 {
+	basis := make([][]float64, 0, dim)
+	if len(q) > 0 {
+		for ci := range q[0] {
+			col := make([]float64, dim)
+			for ri := range col {
+				col[ri] = q[ri][ci]
+			}
+			basis = append(basis, col)
+		}
+	}
+
+	orthogonalize := func(x []float64) {
+		for pass := 0; pass < 2; pass++ {
+			for _, b := range basis {
+				var dp float64
+				for i := range x {
+					dp += b[i] * x[i]
+				}
+				for i := range x {
+					x[i] -= dp * b[i]
+				}
+			}
+		}
+	}
+
+	for len(basis) < dim {
+		var best []float64
+		var bestNorm float64
+		for i := 0; i < dim; i++ {
+			x := make([]float64, dim)
+			x[i] = 1
+			orthogonalize(x)
+
+			var norm float64
+			for _, val := range x {
+				norm += val * val
+			}
+			if norm > bestNorm {
+				best = x
+				bestNorm = norm
+			}
+		}
+
+		scale := 1 / math.Sqrt(bestNorm)
+		for i := range best {
+			best[i] *= scale
+		}
+		orthogonalize(best)
+		var norm float64
+		for _, val := range best {
+			norm += val * val
+		}
+		scale = 1 / math.Sqrt(norm)
+		for i := range best {
+			best[i] *= scale
+		}
+		basis = append(basis, best)
+	}
+
+	rows := make([][]float64, dim)
+	for ri := range rows {
+		rows[ri] = make([]float64, dim)
+		for ci, col := range basis {
+			rows[ri][ci] = col[ri]
+		}
+	}
+
+	return rows
+}
+
+// fromFloat64Rows builds a Matrix with the given number of columns from
+// row-major float64 data.  The column count is passed explicitly so that a
+// Matrix with no rows keeps its shape.
+func fromFloat64Rows[N constraints.Float](rows [][]float64, cols int) Matrix[N] {
+	m := newMatrix[N](len(rows), cols)
+	for ri, row := range rows {
+		for ci, val := range row {
+			m.data[ci*m.stride+ri] = N(val)
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,202 @@
+package wyvern_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ScarletTanager/wyvern"
+)
+
+// expectReconstruction asserts that U Σ V' equals the rows of the original matrix.
+func expectReconstruction(f *wyvern.SVD[float64], rows []wyvern.Vector[float64]) {
+	us := must(f.U().Product(f.Sigma()))
+	usvt := must(us.Product(f.VT()))
+	expectVectorsNear(usvt.Rows(), rows, 1e-10)
+}
+
+var _ = Describe("SVD", func() {
+	var (
+		mt   wyvern.Matrix[float64]
+		rows []wyvern.Vector[float64]
+	)
+
+	BeforeEach(func() {
+		rows = []wyvern.Vector[float64]{
+			{3, 2, 2},
+			{2, 3, -2},
+		}
+	})
+
+	JustBeforeEach(func() {
+		mt, _ = wyvern.FromRows(rows)
+	})
+
+	Describe("SVD", func() {
+		It("Returns the singular values in descending order", func() {
+			f, e := mt.SVD()
+			Expect(e).NotTo(HaveOccurred())
+			expectVectorNear(f.Values(), wyvern.Vector[float64]{5, 3}, 1e-12)
+		})
+
+		It("Returns thin factors which reconstruct the matrix", func() {
+			f, _ := mt.SVD()
+			Expect(f.U().Columns()).To(HaveLen(2))
+			Expect(f.V().Columns()).To(HaveLen(2))
+			Expect(f.V().Rows()).To(HaveLen(3))
+			expectOrthonormalColumns(f.U())
+			expectOrthonormalColumns(f.V())
+			expectReconstruction(f, rows)
+		})
+
+		When("The matrix has more rows than columns", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{3, 2},
+					{2, 3},
+					{2, -2},
+				}
+			})
+
+			It("Returns thin factors which reconstruct the matrix", func() {
+				f, e := mt.SVD()
+				Expect(e).NotTo(HaveOccurred())
+				expectVectorNear(f.Values(), wyvern.Vector[float64]{5, 3}, 1e-12)
+				Expect(f.U().Columns()).To(HaveLen(2))
+				Expect(f.U().Rows()).To(HaveLen(3))
+				expectOrthonormalColumns(f.U())
+				expectOrthonormalColumns(f.V())
+				expectReconstruction(f, rows)
+			})
+		})
+
+		It("Leaves the original matrix unchanged", func() {
+			mt.SVD()
+			Expect(mt.Rows()).To(Equal(rows))
+		})
+	})
+
+	Describe("FullSVD", func() {
+		It("Returns square U and V which reconstruct the matrix", func() {
+			f, e := mt.FullSVD()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(f.U().Columns()).To(HaveLen(2))
+			Expect(f.V().Columns()).To(HaveLen(3))
+			Expect(f.Sigma().Rows()).To(HaveLen(2))
+			Expect(f.Sigma().Columns()).To(HaveLen(3))
+			expectOrthonormalColumns(f.U())
+			expectOrthonormalColumns(f.V())
+			expectReconstruction(f, rows)
+		})
+	})
+
+	Describe("Rank", func() {
+		It("Returns the rank of a full rank matrix", func() {
+			Expect(mt.Rank(0)).To(Equal(2))
+		})
+
+		When("The matrix is rank deficient", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2},
+					{2, 4},
+					{3, 6},
+				}
+			})
+
+			It("Returns the rank", func() {
+				Expect(mt.Rank(0)).To(Equal(1))
+			})
+		})
+
+		It("Uses the supplied tolerance", func() {
+			Expect(mt.Rank(4)).To(Equal(1))
+		})
+	})
+
+	Describe("PseudoInverse", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 0},
+				{1, 1},
+				{1, 2},
+			}
+		})
+
+		It("Returns a left inverse of a matrix with independent columns", func() {
+			pinv, e := mt.PseudoInverse()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(pinv.Rows()).To(HaveLen(2))
+
+			product := must(pinv.Product(mt))
+			expectVectorsNear(product.Rows(), []wyvern.Vector[float64]{
+				{1, 0},
+				{0, 1},
+			}, 1e-12)
+		})
+
+		When("The matrix is rank deficient", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2},
+					{2, 4},
+					{3, 6},
+				}
+			})
+
+			It("Satisfies A A⁺ A = A", func() {
+				pinv, _ := mt.PseudoInverse()
+				product := must(must(mt.Product(pinv)).Product(mt))
+				expectVectorsNear(product.Rows(), rows, 1e-12)
+			})
+		})
+	})
+
+	Describe("ConditionNumber", func() {
+		BeforeEach(func() {
+			rows = []wyvern.Vector[float64]{
+				{1, 0},
+				{0, 10},
+			}
+		})
+
+		It("Returns the ratio of the extreme singular values", func() {
+			Expect(mt.ConditionNumber()).To(BeNumerically("~", 10, 1e-12))
+		})
+
+		When("The matrix is singular", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{1, 2},
+					{2, 4},
+				}
+			})
+
+			It("Returns +Inf", func() {
+				Expect(mt.ConditionNumber()).To(Equal(math.Inf(1)))
+			})
+		})
+
+		When("The matrix is numerically rank deficient", func() {
+			BeforeEach(func() {
+				rows = []wyvern.Vector[float64]{
+					{0.1, 0.2, 0.3},
+					{0.4, 0.5, 0.6},
+					{0.1, 0.2, 0.3},
+				}
+			})
+
+			It("Returns +Inf", func() {
+				Expect(mt.Rank(0)).To(Equal(2))
+				Expect(mt.ConditionNumber()).To(Equal(math.Inf(1)))
+			})
+		})
+	})
+
+	Describe("Norm2", func() {
+		It("Returns the largest singular value", func() {
+			Expect(mt.Norm2()).To(BeNumerically("~", 5, 1e-12))
+		})
+	})
+})
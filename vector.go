@@ -1,15 +1,29 @@
 package wyvern
 
 import (
-	"errors"
 	"math"
 
 	"golang.org/x/exp/constraints"
 )
 
+// A Vector is an ordered list of components.
+//
+// Operations combining two vectors require them to have the same dimension, and
+// report a *DimensionError (matching ErrDimensionMismatch) when they do not.
 type Vector[N constraints.Float] []N
 
-func (v Vector[N]) DotProduct(w Vector[N]) N {
+// DotProduct returns the dot product of v and w.  A *DimensionError is returned
+// if the vectors have different dimensions.
+func (v Vector[N]) DotProduct(w Vector[N]) (N, error) {
+	if !v.sameDimension(w) {
+		return 0, v.dimensionError("DotProduct", w)
+	}
+
+	return v.dot(w), nil
+}
+
+// dot returns the dot product of v and w, which must have the same dimension.
+func (v Vector[N]) dot(w Vector[N]) N {
 	var (
 		dp N
 	)
@@ -21,6 +35,7 @@ func (v Vector[N]) DotProduct(w Vector[N]) N {
 	return dp
 }
 
+// Magnitude returns the Euclidean length of the vector.
 func (v Vector[N]) Magnitude() float64 {
 	var sumOfSquaredComponents float64
 	for _, c := range v {
@@ -34,8 +49,23 @@ func (v Vector[N]) sameDimension(w Vector[N]) bool {
 	return len(v) == len(w)
 }
 
-func (v Vector[N]) Angle(w Vector[N]) float64 {
-	return math.Acos(float64(v.DotProduct(w)) / (v.Magnitude() * w.Magnitude()))
+// Angle returns the angle, in radians, between v and w.  A *DimensionError is
+// returned if the vectors have different dimensions, and ErrZeroVector if either
+// has zero magnitude, since the angle is then undefined.
+func (v Vector[N]) Angle(w Vector[N]) (float64, error) {
+	if !v.sameDimension(w) {
+		return 0, v.dimensionError("Angle", w)
+	}
+
+	m := v.Magnitude() * w.Magnitude()
+	if m == 0 {
+		return 0, ErrZeroVector
+	}
+
+	// Rounding can push the cosine of (anti)parallel vectors just
+	// outside [-1, 1], where Acos is NaN.
+	cos := max(-1, min(1, float64(v.dot(w))/m))
+	return math.Acos(cos), nil
 }
 
 // Multiply multiplies each component of the vector by the specified factor.  It
@@ -48,27 +78,33 @@ func (v Vector[N]) Multiply(f N) Vector[N] {
 	return v
 }
 
-// Difference returns (v-other) as a new Vector - it does not modify the original Vector.
-// If other has fewer dimensions than v, then the components of other are subtracted
-// from the corresponding first n components of v, where n is the dimension of other.
-// The remaining components are left unchanged (as if other had 0 values for those components).
-func (v Vector[N]) Difference(other Vector[N]) Vector[N] {
-	d := make(Vector[N], len(v))
+// Difference returns (v-other) as a new Vector - it does not modify the original
+// Vector.  A *DimensionError is returned if the vectors have different
+// dimensions.
+func (v Vector[N]) Difference(other Vector[N]) (Vector[N], error) {
+	if !v.sameDimension(other) {
+		return nil, v.dimensionError("Difference", other)
+	}
+
+	return v.difference(other), nil
+}
 
+// difference returns (v-other) as a new Vector.  The vectors must have the same
+// dimension.
+func (v Vector[N]) difference(other Vector[N]) Vector[N] {
+	d := make(Vector[N], len(v))
 	for ci, orig := range v {
-		if ci < len(other) {
-			d[ci] = orig - other[ci]
-		} else {
-			d[ci] = orig
-		}
+		d[ci] = orig - other[ci]
 	}
 
 	return d
 }
 
+// MultiplyComponent multiplies the specified component by the given factor.
+// Returns an error if the component index is out of range.
 func (v Vector[N]) MultiplyComponent(componentIndex int, f N) error {
 	if componentIndex < 0 || componentIndex >= len(v) {
-		return errors.New("Component index out of range for vector")
+		return &IndexError{Op: "MultiplyComponent", Index: componentIndex, Limit: len(v)}
 	}
 
 	v.multiplyComponent(componentIndex, f)
@@ -79,3 +115,140 @@ func (v Vector[N]) multiplyComponent(componentIndex int, f N) {
 	prevVal := v[componentIndex]
 	v[componentIndex] = prevVal * f
 }
+
+// Add returns (v+other) as a new Vector - it does not modify the original Vector.
+// A *DimensionError is returned if the vectors have different dimensions.
+func (v Vector[N]) Add(other Vector[N]) (Vector[N], error) {
+	if !v.sameDimension(other) {
+		return nil, v.dimensionError("Add", other)
+	}
+
+	s := make(Vector[N], len(v))
+	for ci, orig := range v {
+		s[ci] = orig + other[ci]
+	}
+
+	return s, nil
+}
+
+// Sum returns the sum of the supplied vectors as a new Vector.  A *DimensionError
+// is returned if the vectors do not all have the same dimension.
+func Sum[N constraints.Float](vectors ...Vector[N]) (Vector[N], error) {
+	if len(vectors) == 0 {
+		return Vector[N]{}, nil
+	}
+
+	s := make(Vector[N], len(vectors[0]))
+	for _, w := range vectors {
+		if !s.sameDimension(w) {
+			return nil, s.dimensionError("Sum", w)
+		}
+
+		for ci, val := range w {
+			s[ci] += val
+		}
+	}
+
+	return s, nil
+}
+
+// Normalize returns the unit vector in the direction of v as a new Vector.
+// ErrZeroVector is returned if v has zero magnitude.
+func (v Vector[N]) Normalize() (Vector[N], error) {
+	m := v.Magnitude()
+	if m == 0 {
+		return nil, ErrZeroVector
+	}
+
+	n := make(Vector[N], len(v))
+	for ci, orig := range v {
+		n[ci] = orig / N(m)
+	}
+
+	return n, nil
+}
+
+// Cross returns the cross product (v x other) as a new Vector.  Both vectors must
+// be three-dimensional; a *DimensionError is returned otherwise.
+func (v Vector[N]) Cross(other Vector[N]) (Vector[N], error) {
+	for _, w := range []Vector[N]{v, other} {
+		if len(w) != 3 {
+			return nil, &DimensionError{
+				Op:       "Cross",
+				Expected: Shape{Rows: 3, Columns: 1},
+				Actual:   Shape{Rows: len(w), Columns: 1},
+			}
+		}
+	}
+
+	return Vector[N]{
+		v[1]*other[2] - v[2]*other[1],
+		v[2]*other[0] - v[0]*other[2],
+		v[0]*other[1] - v[1]*other[0],
+	}, nil
+}
+
+// Project returns the projection of v onto other - the component of v lying in
+// the direction of other - as a new Vector.  ErrZeroVector is returned if other
+// has zero magnitude, and a *DimensionError if the vectors have different
+// dimensions.
+func (v Vector[N]) Project(other Vector[N]) (Vector[N], error) {
+	if !v.sameDimension(other) {
+		return nil, v.dimensionError("Project", other)
+	}
+
+	oo := other.dot(other)
+	if oo == 0 {
+		return nil, ErrZeroVector
+	}
+
+	return append(Vector[N]{}, other...).Multiply(v.dot(other) / oo), nil
+}
+
+// Reject returns the rejection of v from other - the component of v orthogonal
+// to other, so that v is the sum of its projection onto and rejection from
+// other - as a new Vector.  Errors are returned as for Project.
+func (v Vector[N]) Reject(other Vector[N]) (Vector[N], error) {
+	p, err := v.Project(other)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.difference(p), nil
+}
+
+// Lerp linearly interpolates between v (at t = 0) and other (at t = 1), returning
+// v + t(other - v) as a new Vector.  Values of t outside [0, 1] extrapolate.  A
+// *DimensionError is returned if the vectors have different dimensions.
+func (v Vector[N]) Lerp(other Vector[N], t N) (Vector[N], error) {
+	if !v.sameDimension(other) {
+		return nil, v.dimensionError("Lerp", other)
+	}
+
+	l := make(Vector[N], len(v))
+	for ci, orig := range v {
+		l[ci] = orig + t*(other[ci]-orig)
+	}
+
+	return l, nil
+}
+
+// Distance returns the Euclidean distance between v and other.  A
+// *DimensionError is returned if the vectors have different dimensions.
+func (v Vector[N]) Distance(other Vector[N]) (float64, error) {
+	if !v.sameDimension(other) {
+		return 0, v.dimensionError("Distance", other)
+	}
+
+	return v.difference(other).Magnitude(), nil
+}
+
+// dimensionError returns a *DimensionError for an operation which required other
+// to have the same dimension as v.
+func (v Vector[N]) dimensionError(op string, other Vector[N]) error {
+	return &DimensionError{
+		Op:       op,
+		Expected: Shape{Rows: len(v), Columns: 1},
+		Actual:   Shape{Rows: len(other), Columns: 1},
+	}
+}
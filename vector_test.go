@@ -1,6 +1,7 @@
 package wyvern_test
 
 import (
+	"errors"
 	"math"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -23,6 +24,18 @@ var _ = Describe("Vector", func() {
 		It("computes the correct dot product", func() {
 			Expect(v.DotProduct(w)).To(Equal(32.0))
 		})
+
+		When("The vectors have different dimensions", func() {
+			It("Returns a DimensionError instead of panicking", func() {
+				_, e := v.DotProduct(wyvern.Vector[float64]{1, 2})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+
+				var de *wyvern.DimensionError
+				Expect(errors.As(e, &de)).To(BeTrue())
+				Expect(de.Expected).To(Equal(wyvern.Shape{Rows: 3, Columns: 1}))
+				Expect(de.Actual).To(Equal(wyvern.Shape{Rows: 2, Columns: 1}))
+			})
+		})
 	})
 
 	Describe("Magnitude", func() {
@@ -44,6 +57,25 @@ var _ = Describe("Vector", func() {
 		It("Returns the angle in radians between the two vectors", func() {
 			Expect(v.Angle(w)).To(Equal(math.Pi / 2))
 		})
+
+		It("Returns zero for parallel vectors", func() {
+			u := wyvern.Vector[float64]{0.1, 0.2, 0.3}
+			Expect(u.Angle(wyvern.Vector[float64]{0.3, 0.6, 0.9})).To(BeNumerically("~", 0, 1e-7))
+		})
+
+		When("Either vector is zero", func() {
+			It("Returns ErrZeroVector instead of NaN", func() {
+				_, e := v.Angle(wyvern.Vector[float64]{0, 0})
+				Expect(e).To(MatchError(wyvern.ErrZeroVector))
+			})
+		})
+
+		When("The vectors have different dimensions", func() {
+			It("Returns a DimensionError", func() {
+				_, e := v.Angle(wyvern.Vector[float64]{1, 2, 3})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
 	})
 
 	Describe("MultiplyComponent", func() {
@@ -57,6 +89,18 @@ var _ = Describe("Vector", func() {
 			v.MultiplyComponent(1, 3.0)
 			Expect(v).To(Equal(w))
 		})
+
+		When("The component index is out of range", func() {
+			It("Returns an IndexError and leaves the vector unchanged", func() {
+				e := v.MultiplyComponent(2, 3.0)
+				Expect(e).To(MatchError(wyvern.ErrIndexOutOfRange))
+
+				var ie *wyvern.IndexError
+				Expect(errors.As(e, &ie)).To(BeTrue())
+				Expect(ie.Limit).To(Equal(2))
+				Expect(v).To(Equal(wyvern.Vector[float64]{3, 4}))
+			})
+		})
 	})
 
 	Describe("Multiply", func() {
@@ -84,8 +128,7 @@ var _ = Describe("Vector", func() {
 		})
 
 		It("Returns the difference of the two vectors", func() {
-			d := v.Difference(w)
-			Expect(d).To(Equal(wyvern.Vector[float64]{7, 5, 5}))
+			Expect(v.Difference(w)).To(Equal(wyvern.Vector[float64]{7, 5, 5}))
 		})
 
 		It("Leaves the original vector unchanged", func() {
@@ -98,9 +141,169 @@ var _ = Describe("Vector", func() {
 				w = wyvern.Vector[float64]{5, 3}
 			})
 
-			It("Returns the difference as if the vector to be subtracted had zeros for the missing components", func() {
-				d := v.Difference(w)
-				Expect(d).To(Equal(wyvern.Vector[float64]{7, 5, 3}))
+			It("Returns a DimensionError rather than padding with zeros", func() {
+				d, e := v.Difference(w)
+				Expect(d).To(BeNil())
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Add", func() {
+		It("Returns the sum and leaves the original vectors unchanged", func() {
+			s, e := v.Add(w)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(s).To(Equal(wyvern.Vector[float64]{5, 7, 9}))
+			Expect(v).To(Equal(wyvern.Vector[float64]{1, 2, 3}))
+			Expect(w).To(Equal(wyvern.Vector[float64]{4, 5, 6}))
+		})
+
+		When("The vectors have different dimensions", func() {
+			It("Returns a DimensionError", func() {
+				s, e := v.Add(wyvern.Vector[float64]{1, 2})
+				Expect(s).To(BeNil())
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Sum", func() {
+		It("Returns the sum of all the vectors", func() {
+			s, e := wyvern.Sum(v, w, wyvern.Vector[float64]{-5, -7, -9})
+			Expect(e).NotTo(HaveOccurred())
+			Expect(s).To(Equal(wyvern.Vector[float64]{0, 0, 0}))
+			Expect(v).To(Equal(wyvern.Vector[float64]{1, 2, 3}))
+		})
+
+		It("Returns an empty vector when there is nothing to sum", func() {
+			s, e := wyvern.Sum[float64]()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(s).To(BeEmpty())
+		})
+
+		When("The vectors have different dimensions", func() {
+			It("Returns a DimensionError", func() {
+				_, e := wyvern.Sum(v, w, wyvern.Vector[float64]{1})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Normalize", func() {
+		BeforeEach(func() {
+			v = wyvern.Vector[float64]{3, 0, 4}
+		})
+
+		It("Returns the unit vector in the same direction", func() {
+			n, e := v.Normalize()
+			Expect(e).NotTo(HaveOccurred())
+			Expect(n).To(Equal(wyvern.Vector[float64]{0.6, 0, 0.8}))
+			Expect(v).To(Equal(wyvern.Vector[float64]{3, 0, 4}))
+		})
+
+		When("The vector is zero", func() {
+			It("Returns ErrZeroVector", func() {
+				_, e := wyvern.Vector[float64]{0, 0}.Normalize()
+				Expect(e).To(MatchError(wyvern.ErrZeroVector))
+			})
+		})
+	})
+
+	Describe("Cross", func() {
+		It("Returns the cross product", func() {
+			c, e := v.Cross(w)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(c).To(Equal(wyvern.Vector[float64]{-3, 6, -3}))
+			Expect(c.DotProduct(v)).To(BeZero())
+			Expect(c.DotProduct(w)).To(BeZero())
+		})
+
+		It("Is anticommutative", func() {
+			c1, _ := v.Cross(w)
+			c2, _ := w.Cross(v)
+			Expect(c2).To(Equal(c1.Multiply(-1)))
+		})
+
+		When("The vectors are not three-dimensional", func() {
+			It("Returns a DimensionError", func() {
+				_, e := wyvern.Vector[float64]{1, 2}.Cross(wyvern.Vector[float64]{3, 4})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Project and Reject", func() {
+		BeforeEach(func() {
+			v = wyvern.Vector[float64]{3, 4}
+			w = wyvern.Vector[float64]{2, 0}
+		})
+
+		It("Project returns the component in the direction of the other vector", func() {
+			p, e := v.Project(w)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(p).To(Equal(wyvern.Vector[float64]{3, 0}))
+			Expect(w).To(Equal(wyvern.Vector[float64]{2, 0}))
+		})
+
+		It("Reject returns the component orthogonal to the other vector", func() {
+			r, e := v.Reject(w)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(r).To(Equal(wyvern.Vector[float64]{0, 4}))
+		})
+
+		When("The other vector is zero", func() {
+			It("Returns ErrZeroVector", func() {
+				_, e := v.Project(wyvern.Vector[float64]{0, 0})
+				Expect(e).To(MatchError(wyvern.ErrZeroVector))
+
+				_, e = v.Reject(wyvern.Vector[float64]{0, 0})
+				Expect(e).To(MatchError(wyvern.ErrZeroVector))
+			})
+		})
+
+		When("The vectors have different dimensions", func() {
+			It("Returns a DimensionError", func() {
+				_, e := v.Project(wyvern.Vector[float64]{1, 2, 3})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Lerp", func() {
+		It("Interpolates between the two vectors", func() {
+			l, e := v.Lerp(w, 0.5)
+			Expect(e).NotTo(HaveOccurred())
+			Expect(l).To(Equal(wyvern.Vector[float64]{2.5, 3.5, 4.5}))
+
+			l, _ = v.Lerp(w, 0)
+			Expect(l).To(Equal(v))
+
+			l, _ = v.Lerp(w, 1)
+			Expect(l).To(Equal(w))
+		})
+
+		When("The vectors have different dimensions", func() {
+			It("Returns a DimensionError", func() {
+				_, e := v.Lerp(wyvern.Vector[float64]{1}, 0.5)
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
+			})
+		})
+	})
+
+	Describe("Distance", func() {
+		BeforeEach(func() {
+			v = wyvern.Vector[float64]{1, 1}
+			w = wyvern.Vector[float64]{4, 5}
+		})
+
+		It("Returns the distance between the vectors", func() {
+			Expect(v.Distance(w)).To(Equal(5.0))
+		})
+
+		When("The vectors have different dimensions", func() {
+			It("Returns a DimensionError", func() {
+				_, e := v.Distance(wyvern.Vector[float64]{1})
+				Expect(e).To(MatchError(wyvern.ErrDimensionMismatch))
 			})
 		})
 	})
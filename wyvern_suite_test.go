@@ -3,6 +3,8 @@ package wyvern_test
 import (
 	"testing"
 
+	"github.com/ScarletTanager/wyvern"
+	"github.com/ScarletTanager/wyvern/internal/testutil"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -11,3 +13,15 @@ func TestWyvern(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Wyvern Suite")
 }
+
+// The shared assertions, under the names used throughout the suite.
+var (
+	expectVectorNear  = testutil.ExpectVectorNear
+	expectVectorsNear = testutil.ExpectVectorsNear
+)
+
+// must returns m, failing the current spec if err is not nil.
+func must(m wyvern.Matrix[float64], err error) wyvern.Matrix[float64] {
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return m
+}